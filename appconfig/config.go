@@ -0,0 +1,104 @@
+package appconfig
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"sigs.k8s.io/yaml"
+
+	appv1alpha1 "github.com/cosmos/cosmos-sdk/api/cosmos/app/v1alpha1"
+	"github.com/cosmos/cosmos-sdk/container"
+)
+
+// LoadJSON loads an app config in JSON format.
+func LoadJSON(bz []byte) container.Option {
+	config := &appv1alpha1.Config{}
+	err := protojson.Unmarshal(bz, config)
+	if err != nil {
+		return container.Error(err)
+	}
+
+	return Compose(config)
+}
+
+// LoadYAML loads an app config in YAML format.
+func LoadYAML(bz []byte) container.Option {
+	j, err := yaml.YAMLToJSON(bz)
+	if err != nil {
+		return container.Error(err)
+	}
+
+	return LoadJSON(j)
+}
+
+// Compose composes a dependency injection container option from an app
+// config. Each module config message is supplied to the container and the
+// providers registered for its type with Register are provided within the
+// scope of the module's name. The app config itself is supplied as well.
+func Compose(appConfig *appv1alpha1.Config) container.Option {
+	opts := []container.Option{
+		container.Supply(appConfig),
+	}
+
+	names := map[string]bool{}
+	types := map[protoreflect.FullName]string{}
+	for _, module := range appConfig.Modules {
+		if module.Name == "" {
+			return container.Error(fmt.Errorf("module is missing name"))
+		}
+
+		if names[module.Name] {
+			return container.Error(fmt.Errorf("duplicate module name %s", module.Name))
+		}
+		names[module.Name] = true
+
+		if module.Config == nil {
+			return container.Error(fmt.Errorf("module %s is missing a config object", module.Name))
+		}
+
+		msgType, err := protoregistry.GlobalTypes.FindMessageByURL(module.Config.TypeUrl)
+		if err != nil {
+			return container.Error(fmt.Errorf("unable to resolve the config object %s of module %s, "+
+				"did you forget to import the module's package? %w", module.Config.TypeUrl, module.Name, err))
+		}
+
+		fullName := msgType.Descriptor().FullName()
+		init, ok := moduleRegistry[fullName]
+		if !ok {
+			modDesc, _ := proto.GetExtension(msgType.Descriptor().Options(), appv1alpha1.E_Module).(*appv1alpha1.ModuleDescriptor)
+			if modDesc == nil {
+				return container.Error(fmt.Errorf("%s is not a module config message", fullName))
+			}
+
+			return container.Error(fmt.Errorf("no module registered for %s, did you forget to import %s?",
+				fullName, modDesc.GoImport))
+		}
+
+		if existing, ok := types[fullName]; ok {
+			return container.Error(fmt.Errorf("module %s uses the config object %s which is already used by module %s",
+				module.Name, fullName, existing))
+		}
+		types[fullName] = module.Name
+
+		config := init.msg.ProtoReflect().Type().New().Interface()
+		err = module.Config.UnmarshalTo(config)
+		if err != nil {
+			return container.Error(fmt.Errorf("unable to unmarshal the config object of module %s: %w", module.Name, err))
+		}
+
+		opts = append(opts, container.Supply(config))
+
+		if len(init.providers) != 0 {
+			opts = append(opts, container.ProvideInModule(module.Name, init.providers...))
+		}
+
+		if len(init.moduleScopedProviders) != 0 {
+			opts = append(opts, container.Provide(init.moduleScopedProviders...))
+		}
+	}
+
+	return container.Options(opts...)
+}
@@ -0,0 +1,77 @@
+package appconfig_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	runtimev1alpha1 "github.com/cosmos/cosmos-sdk/api/cosmos/app/runtime/v1alpha1"
+	"github.com/cosmos/cosmos-sdk/appconfig"
+	"github.com/cosmos/cosmos-sdk/container"
+	_ "github.com/cosmos/cosmos-sdk/runtime"
+)
+
+func expectContainerErrorContains(t *testing.T, option container.Option, contains string) {
+	t.Helper()
+	err := container.Run(func() {}, option)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), contains)
+}
+
+func TestCompose(t *testing.T) {
+	expectContainerErrorContains(t, appconfig.LoadYAML([]byte("{")), "did not find expected node content")
+
+	expectContainerErrorContains(t, appconfig.LoadYAML([]byte(`
+modules:
+- config:
+    "@type": cosmos.app.runtime.v1alpha1.Module
+`)), "module is missing name")
+
+	expectContainerErrorContains(t, appconfig.LoadYAML([]byte(`
+modules:
+- name: runtime
+`)), "module runtime is missing a config object")
+
+	expectContainerErrorContains(t, appconfig.LoadYAML([]byte(`
+modules:
+- name: runtime
+  config:
+    "@type": cosmos.app.runtime.v1alpha1.Module
+- name: runtime
+  config:
+    "@type": cosmos.app.runtime.v1alpha1.Module
+`)), "duplicate module name runtime")
+
+	expectContainerErrorContains(t, appconfig.LoadYAML([]byte(`
+modules:
+- name: a
+  config:
+    "@type": cosmos.app.runtime.v1alpha1.Module
+- name: b
+  config:
+    "@type": cosmos.app.runtime.v1alpha1.Module
+`)), "module b uses the config object cosmos.app.runtime.v1alpha1.Module which is already used by module a")
+
+	expectContainerErrorContains(t, appconfig.LoadYAML([]byte(`
+modules:
+- name: runtime
+  config:
+    "@type": cosmos.app.v1alpha1.Config
+`)), "cosmos.app.v1alpha1.Config is not a module config message")
+
+	var config *runtimev1alpha1.Module
+	require.NoError(t, container.Run(func(c *runtimev1alpha1.Module) { config = c }, appconfig.LoadYAML([]byte(`
+modules:
+- name: runtime
+  config:
+    "@type": cosmos.app.runtime.v1alpha1.Module
+    app_name: foo
+`))))
+	require.Equal(t, "foo", config.AppName)
+}
+
+func TestRegister(t *testing.T) {
+	require.Panics(t, func() {
+		appconfig.Register(&runtimev1alpha1.Module{})
+	})
+}
@@ -0,0 +1,82 @@
+package appconfig
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	appv1alpha1 "github.com/cosmos/cosmos-sdk/api/cosmos/app/v1alpha1"
+)
+
+// moduleRegistry is the registry of module initializers keyed by the full
+// name of their config message.
+var moduleRegistry = map[protoreflect.FullName]*moduleInitializer{}
+
+type moduleInitializer struct {
+	msg                   proto.Message
+	providers             []interface{}
+	moduleScopedProviders []interface{}
+}
+
+// Option is a functional option for implementing modules.
+type Option interface {
+	apply(*moduleInitializer) error
+}
+
+type funcOption func(initializer *moduleInitializer) error
+
+func (f funcOption) apply(initializer *moduleInitializer) error {
+	return f(initializer)
+}
+
+// Provide registers providers with the dependency injection system that will
+// be run within the module scope of the module's name in the app config. See
+// container.ProvideInModule for more information.
+func Provide(providers ...interface{}) Option {
+	return funcOption(func(initializer *moduleInitializer) error {
+		initializer.providers = append(initializer.providers, providers...)
+		return nil
+	})
+}
+
+// ProvideModuleScoped registers module-scoped providers with the dependency
+// injection system. Module-scoped providers declare a container.ModuleKey
+// input and are called once for each module which depends on them, for
+// instance to create a store key for each module. See container.Provide for
+// more information.
+func ProvideModuleScoped(providers ...interface{}) Option {
+	return funcOption(func(initializer *moduleInitializer) error {
+		initializer.moduleScopedProviders = append(initializer.moduleScopedProviders, providers...)
+		return nil
+	})
+}
+
+// Register registers a module with the provided type name (ex. cosmos.bank.module.v1.Module)
+// and the provided options. The config message must be annotated with the
+// cosmos.app.v1alpha1.module option. Register is intended to be called from
+// the init function of the package referenced by the module's go_import.
+func Register(msg proto.Message, options ...Option) {
+	ty := msg.ProtoReflect().Descriptor()
+	if !proto.HasExtension(ty.Options(), appv1alpha1.E_Module) {
+		panic(fmt.Errorf("%s is not a module config message because it isn't annotated with the %s option",
+			ty.FullName(), appv1alpha1.E_Module.TypeDescriptor().FullName()))
+	}
+
+	if _, ok := moduleRegistry[ty.FullName()]; ok {
+		panic(fmt.Errorf("module %s has already been registered", ty.FullName()))
+	}
+
+	init := &moduleInitializer{
+		msg: msg,
+	}
+
+	for _, option := range options {
+		err := option.apply(init)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	moduleRegistry[ty.FullName()] = init
+}
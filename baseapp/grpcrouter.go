@@ -6,22 +6,30 @@ import (
 	"google.golang.org/grpc/encoding"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/unknownproto"
 
 	"github.com/cosmos/cosmos-sdk/client/grpc/reflection"
 
 	gogogrpc "github.com/gogo/protobuf/grpc"
+	"github.com/gogo/protobuf/proto"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"google.golang.org/grpc"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // GRPCQueryRouter routes ABCI Query requests to GRPC handlers
 type GRPCQueryRouter struct {
-	routes      map[string]GRPCQueryHandler
-	cdc         encoding.Codec
-	serviceData []serviceData
+	routes            map[string]GRPCQueryHandler
+	cdc               encoding.Codec
+	interfaceRegistry codectypes.InterfaceRegistry
+	serviceData       []serviceData
+
+	// unknownFieldsStrictness specifies which unknown fields of query
+	// requests are rejected, all of them by default.
+	unknownFieldsStrictness unknownproto.Strictness
 }
 
 // serviceData represents a gRPC service, along with its handler.
@@ -83,6 +91,13 @@ func (qrt *GRPCQueryRouter) RegisterService(sd *grpc.ServiceDesc, handler interf
 			// call the method handler from the service description with the handler object,
 			// a wrapped sdk.Context with proto-unmarshaled data from the ABCI request data
 			res, err := methodHandler(handler, sdk.WrapSDKContext(ctx), func(i interface{}) error {
+				if msg, ok := i.(proto.Message); ok {
+					err := qrt.unknownFieldsStrictness.RejectUnknownFields(req.Data, msg, qrt.interfaceRegistry)
+					if err != nil {
+						return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s: %s", fqName, err)
+					}
+				}
+
 				return qrt.cdc.Unmarshal(req.Data, i)
 			}, nil)
 			if err != nil {
@@ -115,6 +130,7 @@ func (qrt *GRPCQueryRouter) RegisterService(sd *grpc.ServiceDesc, handler interf
 func (qrt *GRPCQueryRouter) SetInterfaceRegistry(interfaceRegistry codectypes.InterfaceRegistry) {
 	// instantiate the codec
 	qrt.cdc = codec.NewProtoCodec(interfaceRegistry).GRPCCodec()
+	qrt.interfaceRegistry = interfaceRegistry
 	// Once we have an interface registry, we can register the interface
 	// registry reflection gRPC service.
 	reflection.RegisterReflectionServiceServer(
@@ -122,3 +138,15 @@ func (qrt *GRPCQueryRouter) SetInterfaceRegistry(interfaceRegistry codectypes.In
 		reflection.NewReflectionServiceServer(interfaceRegistry),
 	)
 }
+
+// SetUnknownFieldsStrictness sets which unknown fields of query requests are
+// rejected by the router.
+func (qrt *GRPCQueryRouter) SetUnknownFieldsStrictness(strictness unknownproto.Strictness) {
+	qrt.unknownFieldsStrictness = strictness
+}
+
+// UnknownFieldsStrictness returns which unknown fields of query requests are
+// rejected by the router.
+func (qrt *GRPCQueryRouter) UnknownFieldsStrictness() unknownproto.Strictness {
+	return qrt.unknownFieldsStrictness
+}
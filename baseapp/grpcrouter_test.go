@@ -8,14 +8,18 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil/testdata_pulsar"
 
 	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
 	dbm "github.com/tendermint/tm-db"
+	"google.golang.org/protobuf/encoding/protowire"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/codec/unknownproto"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 func TestGRPCQueryRouter(t *testing.T) {
@@ -52,6 +56,84 @@ func TestGRPCQueryRouter(t *testing.T) {
 	require.Equal(t, spot, res3.HasAnimal.Animal.GetCachedValue())
 }
 
+func TestGRPCQueryRouterUnknownFields(t *testing.T) {
+	qr := baseapp.NewGRPCQueryRouter()
+	qr.SetInterfaceRegistry(testdata.NewTestInterfaceRegistry())
+	testdata.RegisterQueryServer(qr, testdata.QueryImpl{})
+	ctx := sdk.Context{}.WithContext(context.Background())
+
+	echo, err := (&testdata.EchoRequest{Message: "hello"}).Marshal()
+	require.NoError(t, err)
+	// field 1031 is non-critical as it has bit 11 set
+	echoNonCritical := protowire.AppendVarint(protowire.AppendTag(echo, 1031, protowire.VarintType), 1)
+	echoCritical := protowire.AppendVarint(protowire.AppendTag(echo, 2, protowire.VarintType), 1)
+
+	dog, err := (&testdata.Dog{Name: "Spot"}).Marshal()
+	require.NoError(t, err)
+	dog = protowire.AppendVarint(protowire.AppendTag(dog, 3, protowire.VarintType), 1)
+	anyReq, err := (&testdata.TestAnyRequest{AnyAnimal: &types.Any{TypeUrl: "/testdata.Dog", Value: dog}}).Marshal()
+	require.NoError(t, err)
+
+	query := func(path string, data []byte) error {
+		_, err := qr.Route(path)(ctx, abci.RequestQuery{Data: data})
+		return err
+	}
+
+	// all the unknown fields are rejected by default
+	require.Equal(t, unknownproto.StrictnessAll, qr.UnknownFieldsStrictness())
+	require.NoError(t, query("/testdata.Query/Echo", echo))
+	err = query("/testdata.Query/Echo", echoNonCritical)
+	require.True(t, sdkerrors.ErrInvalidRequest.Is(err), err)
+	err = query("/testdata.Query/Echo", echoCritical)
+	require.True(t, sdkerrors.ErrInvalidRequest.Is(err), err)
+	require.Contains(t, err.Error(), "/testdata.Query/Echo")
+
+	// the path to unknown fields in nested messages is reported
+	err = query("/testdata.Query/TestAny", anyReq)
+	require.True(t, sdkerrors.ErrInvalidRequest.Is(err), err)
+	require.Contains(t, err.Error(), `at "any_animal(/testdata.Dog)"`)
+
+	qr.SetUnknownFieldsStrictness(unknownproto.StrictnessCritical)
+	require.NoError(t, query("/testdata.Query/Echo", echoNonCritical))
+	require.Error(t, query("/testdata.Query/Echo", echoCritical))
+
+	qr.SetUnknownFieldsStrictness(unknownproto.StrictnessNone)
+	require.NoError(t, query("/testdata.Query/Echo", echoNonCritical))
+	require.NoError(t, query("/testdata.Query/Echo", echoCritical))
+	require.NoError(t, query("/testdata.Query/TestAny", anyReq))
+}
+
+func BenchmarkGRPCQueryRouter_strictnessAll(b *testing.B) {
+	benchmarkGRPCQueryRouter(b, unknownproto.StrictnessAll)
+}
+
+func BenchmarkGRPCQueryRouter_strictnessNone(b *testing.B) {
+	benchmarkGRPCQueryRouter(b, unknownproto.StrictnessNone)
+}
+
+func benchmarkGRPCQueryRouter(b *testing.B, strictness unknownproto.Strictness) {
+	qr := baseapp.NewGRPCQueryRouter()
+	qr.SetInterfaceRegistry(testdata.NewTestInterfaceRegistry())
+	qr.SetUnknownFieldsStrictness(strictness)
+	testdata.RegisterQueryServer(qr, testdata.QueryImpl{})
+	ctx := sdk.Context{}.WithContext(context.Background())
+
+	spot, err := types.NewAnyWithValue(&testdata.Dog{Name: "Spot", Size_: "big"})
+	require.NoError(b, err)
+	data, err := (&testdata.TestAnyRequest{AnyAnimal: spot}).Marshal()
+	require.NoError(b, err)
+	handler := qr.Route("/testdata.Query/TestAny")
+
+	b.ReportAllocs()
+	b.SetBytes(int64(len(data)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := handler(ctx, abci.RequestQuery{Data: data}); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
 func TestRegisterQueryServiceTwice(t *testing.T) {
 	// Setup baseapp.
 	db := dbm.NewMemDB()
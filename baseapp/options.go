@@ -7,6 +7,7 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/codec/unknownproto"
 	"github.com/cosmos/cosmos-sdk/snapshots"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -31,6 +32,18 @@ func SetMinGasPrices(gasPricesStr string) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.setMinGasPrices(gasPrices) }
 }
 
+// SetQueryUnknownFields returns an option that sets which unknown fields of
+// gRPC query requests are rejected by the app, one of "all", "critical" or
+// "none".
+func SetQueryUnknownFields(strictnessStr string) func(*BaseApp) {
+	strictness, err := unknownproto.ParseStrictness(strictnessStr)
+	if err != nil {
+		panic(fmt.Sprintf("invalid query unknown fields: %v", err))
+	}
+
+	return func(bapp *BaseApp) { bapp.grpcQueryRouter.SetUnknownFieldsStrictness(strictness) }
+}
+
 // SetHaltHeight returns a BaseApp option function that sets the halt block height.
 func SetHaltHeight(blockHeight uint64) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.setHaltHeight(blockHeight) }
@@ -20,5 +20,15 @@ this behavior, please set the boolean parameter allowUnknownNonCriticals to true
     if err := RejectUnknownFields(protoBlob, protoMessage, true); err != nil {
             // Handle the error.
     }
+
+The Strictness type wraps both functions for callers whose behavior is configured by node operators, such as the
+decoding of gRPC query requests:
+
+    if err := StrictnessCritical.RejectUnknownFields(protoBlob, protoMessage, resolver); err != nil {
+            // Handle the error.
+    }
+
+Errors for unknown fields and mismatched wire types in nested messages include the path to the nested message, e.g.
+"body.messages[0](/cosmos.bank.v1beta1.MsgSend).amount[1]".
 */
 package unknownproto
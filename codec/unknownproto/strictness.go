@@ -0,0 +1,69 @@
+package unknownproto
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+)
+
+// Strictness specifies which unknown fields are rejected when decoding
+// protobuf messages, for instance gRPC query requests.
+type Strictness int
+
+const (
+	// StrictnessAll rejects all the unknown fields. It is the default.
+	StrictnessAll Strictness = iota
+	// StrictnessCritical rejects the unknown fields which are critical, i.e.
+	// whose field number doesn't have bit 11 set, and allows the non-critical
+	// ones.
+	StrictnessCritical
+	// StrictnessNone allows all the unknown fields. They are then silently
+	// dropped by the decoder.
+	StrictnessNone
+)
+
+// ParseStrictness parses a Strictness from its string representation, which is
+// one of "all", "critical" or "none". An empty string is parsed as StrictnessAll.
+func ParseStrictness(s string) (Strictness, error) {
+	switch s {
+	case "all", "":
+		return StrictnessAll, nil
+	case "critical":
+		return StrictnessCritical, nil
+	case "none":
+		return StrictnessNone, nil
+	default:
+		return StrictnessAll, fmt.Errorf("invalid unknown fields strictness %q, expected one of \"all\", \"critical\" or \"none\"", s)
+	}
+}
+
+// String implements fmt.Stringer.
+func (s Strictness) String() string {
+	switch s {
+	case StrictnessAll:
+		return "all"
+	case StrictnessCritical:
+		return "critical"
+	case StrictnessNone:
+		return "none"
+	default:
+		return fmt.Sprintf("Strictness(%d)", int(s))
+	}
+}
+
+// RejectUnknownFields rejects the unknown fields of bz for the provided
+// proto.Message type according to s. Like RejectUnknownFieldsStrict, it
+// traverses inside of messages nested via google.protobuf.Any using the
+// provided resolver, and it doesn't check bz at all with StrictnessNone.
+func (s Strictness) RejectUnknownFields(bz []byte, msg proto.Message, resolver jsonpb.AnyResolver) error {
+	switch s {
+	case StrictnessNone:
+		return nil
+	case StrictnessCritical:
+		_, err := RejectUnknownFields(bz, msg, true, resolver)
+		return err
+	default:
+		return RejectUnknownFieldsStrict(bz, msg, resolver)
+	}
+}
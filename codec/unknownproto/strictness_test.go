@@ -0,0 +1,53 @@
+package unknownproto
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+)
+
+func TestParseStrictness(t *testing.T) {
+	for _, s := range []Strictness{StrictnessAll, StrictnessCritical, StrictnessNone} {
+		parsed, err := ParseStrictness(s.String())
+		require.NoError(t, err)
+		require.Equal(t, s, parsed)
+	}
+
+	parsed, err := ParseStrictness("")
+	require.NoError(t, err)
+	require.Equal(t, StrictnessAll, parsed)
+
+	_, err = ParseStrictness("strict")
+	require.Error(t, err)
+}
+
+func TestStrictnessRejectUnknownFields(t *testing.T) {
+	nonCritical, err := proto.Marshal(&testdata.Customer2{Id: 289, Reserved: 99})
+	require.NoError(t, err)
+	critical, err := proto.Marshal(&testdata.Customer2{Id: 289, City: testdata.Customer2_PaloAlto})
+	require.NoError(t, err)
+
+	tests := []struct {
+		strictness     Strictness
+		wantNonCritErr bool
+		wantCritErr    bool
+	}{
+		{StrictnessAll, true, true},
+		{StrictnessCritical, false, true},
+		{StrictnessNone, false, false},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.strictness.String(), func(t *testing.T) {
+			err := tt.strictness.RejectUnknownFields(nonCritical, new(testdata.Customer1), DefaultAnyResolver{})
+			require.Equal(t, tt.wantNonCritErr, err != nil, err)
+
+			err = tt.strictness.RejectUnknownFields(critical, new(testdata.Customer1), DefaultAnyResolver{})
+			require.Equal(t, tt.wantCritErr, err != nil, err)
+		})
+	}
+}
@@ -53,6 +53,10 @@ func RejectUnknownFields(bz []byte, msg proto.Message, allowUnknownNonCriticals
 		return hasUnknownNonCriticals, err
 	}
 
+	// repeatedIndices tracks the index of the current element of repeated
+	// message fields so that errors can report the path to the offending field.
+	var repeatedIndices map[int32]int
+
 	for len(bz) > 0 {
 		tagNum, wireType, m := protowire.ConsumeTag(bz)
 		if m < 0 {
@@ -124,6 +128,16 @@ func RejectUnknownFields(bz []byte, msg proto.Message, allowUnknownNonCriticals
 		_, o := protowire.ConsumeVarint(fieldBytes)
 		fieldBytes = fieldBytes[o:]
 
+		fieldName := fieldDescProto.GetName()
+		if fieldDescProto.IsRepeated() {
+			if repeatedIndices == nil {
+				repeatedIndices = make(map[int32]int)
+			}
+			index := repeatedIndices[int32(tagNum)]
+			repeatedIndices[int32(tagNum)] = index + 1
+			fieldName = fmt.Sprintf("%s[%d]", fieldName, index)
+		}
+
 		var msg proto.Message
 		var err error
 
@@ -132,7 +146,7 @@ func RejectUnknownFields(bz []byte, msg proto.Message, allowUnknownNonCriticals
 			hasUnknownNonCriticalsChild, err := RejectUnknownFields(fieldBytes, (*types.Any)(nil), allowUnknownNonCriticals, resolver)
 			hasUnknownNonCriticals = hasUnknownNonCriticals || hasUnknownNonCriticalsChild
 			if err != nil {
-				return hasUnknownNonCriticals, err
+				return hasUnknownNonCriticals, prependFieldPath(err, fieldName)
 			}
 			// And finally we can extract the TypeURL containing the protoMessageName.
 			any := new(types.Any)
@@ -141,6 +155,7 @@ func RejectUnknownFields(bz []byte, msg proto.Message, allowUnknownNonCriticals
 			}
 			protoMessageName = any.TypeUrl
 			fieldBytes = any.Value
+			fieldName = fmt.Sprintf("%s(%s)", fieldName, any.TypeUrl)
 			msg, err = resolver.Resolve(protoMessageName)
 			if err != nil {
 				return hasUnknownNonCriticals, err
@@ -155,13 +170,33 @@ func RejectUnknownFields(bz []byte, msg proto.Message, allowUnknownNonCriticals
 		hasUnknownNonCriticalsChild, err := RejectUnknownFields(fieldBytes, msg, allowUnknownNonCriticals, resolver)
 		hasUnknownNonCriticals = hasUnknownNonCriticals || hasUnknownNonCriticalsChild
 		if err != nil {
-			return hasUnknownNonCriticals, err
+			return hasUnknownNonCriticals, prependFieldPath(err, fieldName)
 		}
 	}
 
 	return hasUnknownNonCriticals, nil
 }
 
+// prependFieldPath prepends fieldName to the path of the field in which an
+// unknown field or a mismatched wire type was found. Other errors are returned
+// unchanged.
+func prependFieldPath(err error, fieldName string) error {
+	switch err := err.(type) {
+	case *errUnknownField:
+		err.Path = joinFieldPath(fieldName, err.Path)
+	case *errMismatchedWireType:
+		err.Path = joinFieldPath(fieldName, err.Path)
+	}
+	return err
+}
+
+func joinFieldPath(fieldName, path string) string {
+	if path == "" {
+		return fieldName
+	}
+	return fieldName + "." + path
+}
+
 var protoMessageForTypeNameMu sync.RWMutex
 var protoMessageForTypeNameCache = make(map[string]proto.Message)
 
@@ -272,12 +307,20 @@ type errMismatchedWireType struct {
 	GotWireType  protowire.Type
 	WantWireType protowire.Type
 	TagNum       protowire.Number
+	// Path is the path from the top level message to the message of type
+	// Type, for instance "body.messages[0](/cosmos.bank.v1beta1.MsgSend)".
+	// It is empty if the mismatch is in the top level message.
+	Path string
 }
 
 // String implements fmt.Stringer.
 func (mwt *errMismatchedWireType) String() string {
-	return fmt.Sprintf("Mismatched %q: {TagNum: %d, GotWireType: %q != WantWireType: %q}",
+	s := fmt.Sprintf("Mismatched %q: {TagNum: %d, GotWireType: %q != WantWireType: %q}",
 		mwt.Type, mwt.TagNum, wireTypeToString(mwt.GotWireType), wireTypeToString(mwt.WantWireType))
+	if mwt.Path != "" {
+		s += fmt.Sprintf(" at %q", mwt.Path)
+	}
+	return s
 }
 
 // Error implements the error interface.
@@ -312,12 +355,20 @@ type errUnknownField struct {
 	Type     string
 	TagNum   protowire.Number
 	WireType protowire.Type
+	// Path is the path from the top level message to the message of type
+	// Type, for instance "body.messages[0](/cosmos.bank.v1beta1.MsgSend)".
+	// It is empty if the unknown field is in the top level message.
+	Path string
 }
 
 // String implements fmt.Stringer.
 func (twt *errUnknownField) String() string {
-	return fmt.Sprintf("errUnknownField %q: {TagNum: %d, WireType:%q}",
+	s := fmt.Sprintf("errUnknownField %q: {TagNum: %d, WireType:%q}",
 		twt.Type, twt.TagNum, wireTypeToString(twt.WireType))
+	if twt.Path != "" {
+		s += fmt.Sprintf(" at %q", twt.Path)
+	}
+	return s
 }
 
 // Error implements the error interface.
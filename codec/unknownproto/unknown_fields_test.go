@@ -73,6 +73,7 @@ func TestRejectUnknownFieldsRepeated(t *testing.T) {
 				Type:     "*testdata.TestVersion1",
 				TagNum:   25,
 				WireType: 0,
+				Path:     "c[0].c[2].f",
 			},
 		},
 		{
@@ -128,6 +129,7 @@ func TestRejectUnknownFieldsRepeated(t *testing.T) {
 				Type:     "*testdata.TestVersion1",
 				TagNum:   25,
 				WireType: 0,
+				Path:     "c[0].c[2].f",
 			},
 		},
 		{
@@ -174,6 +176,7 @@ func TestRejectUnknownFieldsRepeated(t *testing.T) {
 				Type:     "*testdata.TestVersion1",
 				TagNum:   1031,
 				WireType: 2,
+				Path:     "c[0].c[2].f",
 			},
 			hasUnknownNonCriticals: true,
 		},
@@ -383,6 +386,7 @@ func TestRejectUnknownFieldsNested(t *testing.T) {
 				Type:     "*testdata.TestVersion3LoneNesting",
 				TagNum:   6,
 				WireType: 0,
+				Path:     "f.f",
 			},
 		},
 		{
@@ -403,6 +407,7 @@ func TestRejectUnknownFieldsNested(t *testing.T) {
 			wantErr: &errUnknownField{
 				Type:   "*testdata.TestVersion1",
 				TagNum: 25,
+				Path:   "g(/testdata.TestVersion1).f",
 			},
 		},
 		{
@@ -428,6 +433,7 @@ func TestRejectUnknownFieldsNested(t *testing.T) {
 				Type:     "*types.Any",
 				TagNum:   3,
 				WireType: 0,
+				Path:     "g",
 			},
 		},
 		{
@@ -449,6 +455,7 @@ func TestRejectUnknownFieldsNested(t *testing.T) {
 				TagNum:       8,
 				GotWireType:  7,
 				WantWireType: 2,
+				Path:         "g(/testdata.TestVersion4LoneNesting).b.a",
 			},
 		},
 		{
@@ -14,6 +14,13 @@ type container struct {
 
 	resolvers map[reflect.Type]resolver
 
+	decorators map[reflect.Type][]*decorator
+	decorated  map[reflect.Type]map[*moduleKey]reflect.Value
+
+	// overridden is the set of types whose providers have been replaced
+	// using Override.
+	overridden map[reflect.Type]bool
+
 	moduleKeys map[string]*moduleKey
 
 	resolveStack []resolveFrame
@@ -30,6 +37,9 @@ func newContainer(cfg *debugConfig) *container {
 	return &container{
 		debugConfig: cfg,
 		resolvers:   map[reflect.Type]resolver{},
+		decorators:  map[reflect.Type][]*decorator{},
+		decorated:   map[reflect.Type]map[*moduleKey]reflect.Value{},
+		overridden:  map[reflect.Type]bool{},
 		moduleKeys:  map[string]*moduleKey{},
 		callerStack: nil,
 		callerMap:   map[Location]bool{},
@@ -37,6 +47,12 @@ func newContainer(cfg *debugConfig) *container {
 }
 
 func (c *container) call(provider *ProviderDescriptor, moduleKey *moduleKey) ([]reflect.Value, error) {
+	return c.callWithInputs(provider, moduleKey, nil)
+}
+
+// callWithInputs calls the provider after resolving its inputs, except for the
+// inputs whose index is in fixedInputs which are passed to the provider as is.
+func (c *container) callWithInputs(provider *ProviderDescriptor, moduleKey *moduleKey, fixedInputs map[int]reflect.Value) ([]reflect.Value, error) {
 	loc := provider.Location
 	graphNode, err := c.locationGraphNode(loc, moduleKey)
 	if err != nil {
@@ -45,7 +61,8 @@ func (c *container) call(provider *ProviderDescriptor, moduleKey *moduleKey) ([]
 	markGraphNodeAsFailed(graphNode)
 
 	if c.callerMap[loc] {
-		return nil, errors.Errorf("cyclic dependency: %s -> %s", loc.Name(), loc.Name())
+		c.highlightFailingPath(loc)
+		return nil, errors.Errorf("cyclic dependency:\n%s", c.formatCycle(loc))
 	}
 
 	c.callerMap[loc] = true
@@ -55,6 +72,11 @@ func (c *container) call(provider *ProviderDescriptor, moduleKey *moduleKey) ([]
 	c.indentLogger()
 	inVals := make([]reflect.Value, len(provider.Inputs))
 	for i, in := range provider.Inputs {
+		if val, ok := fixedInputs[i]; ok {
+			inVals[i] = val
+			continue
+		}
+
 		val, err := c.resolve(in, moduleKey, loc)
 		if err != nil {
 			return nil, err
@@ -69,6 +91,7 @@ func (c *container) call(provider *ProviderDescriptor, moduleKey *moduleKey) ([]
 
 	out, err := provider.Fn(inVals)
 	if err != nil {
+		c.highlightFailingPath(loc)
 		return nil, errors.Wrapf(err, "error calling provider %s", loc)
 	}
 
@@ -194,6 +217,11 @@ func (c *container) addNode(provider *ProviderDescriptor, key *moduleKey) (inter
 				typ = typ.Elem()
 			}
 
+			if c.overridden[typ] {
+				c.logf("Ignoring %v because it has been overridden", typ)
+				continue
+			}
+
 			vr, err := c.getResolver(typ)
 			if err != nil {
 				return nil, err
@@ -214,9 +242,10 @@ func (c *container) addNode(provider *ProviderDescriptor, key *moduleKey) (inter
 				}
 
 				vr = &simpleResolver{
-					node:      sp,
-					typ:       typ,
-					graphNode: typeGraphNode,
+					node:        sp,
+					idxInValues: i,
+					typ:         typ,
+					graphNode:   typeGraphNode,
 				}
 				c.resolvers[typ] = vr
 			}
@@ -239,6 +268,11 @@ func (c *container) addNode(provider *ProviderDescriptor, key *moduleKey) (inter
 		for i, out := range provider.Outputs {
 			typ := out.Type
 
+			if c.overridden[typ] {
+				c.logf("Ignoring %v because it has been overridden", typ)
+				continue
+			}
+
 			c.logf("Registering resolver for module-scoped type %v", typ)
 
 			existing, ok := c.resolvers[typ]
@@ -282,6 +316,11 @@ func (c *container) supply(value reflect.Value, location Location) error {
 
 	c.addGraphEdge(locGrapNode, typeGraphNode)
 
+	if c.overridden[typ] {
+		c.logf("Ignoring supplied %v because it has been overridden", typ)
+		return nil
+	}
+
 	if existing, ok := c.resolvers[typ]; ok {
 		return duplicateDefinitionError(typ, location, existing.describeLocation())
 	}
@@ -298,6 +337,9 @@ func (c *container) supply(value reflect.Value, location Location) error {
 
 func (c *container) resolve(in ProviderInput, moduleKey *moduleKey, caller Location) (reflect.Value, error) {
 	c.resolveStack = append(c.resolveStack, resolveFrame{loc: caller, typ: in.Type})
+	defer func() {
+		c.resolveStack = c.resolveStack[:len(c.resolveStack)-1]
+	}()
 
 	typeGraphNode, err := c.typeGraphNode(in.Type)
 	if err != nil {
@@ -321,10 +363,12 @@ func (c *container) resolve(in ProviderInput, moduleKey *moduleKey, caller Locat
 	if vr == nil {
 		if in.Optional {
 			c.logf("Providing zero value for optional dependency %v", in.Type)
+			markGraphNodeAsOptional(typeGraphNode)
 			return reflect.Zero(in.Type), nil
 		}
 
 		markGraphNodeAsFailed(typeGraphNode)
+		c.highlightFailingPath(nil)
 		return reflect.Value{}, errors.Errorf("can't resolve type %v for %s:\n%s",
 			in.Type, caller, c.formatResolveStack())
 	}
@@ -335,13 +379,58 @@ func (c *container) resolve(in ProviderInput, moduleKey *moduleKey, caller Locat
 		return reflect.Value{}, err
 	}
 
-	markGraphNodeAsUsed(typeGraphNode)
+	if len(c.decorators[in.Type]) != 0 {
+		// module-scoped values are decorated separately for each module
+		decoratedKey := moduleKey
+		if _, ok := vr.(*moduleDepResolver); !ok {
+			decoratedKey = nil
+		}
 
-	c.resolveStack = c.resolveStack[:len(c.resolveStack)-1]
+		res, err = c.decorate(in.Type, res, decoratedKey)
+		if err != nil {
+			markGraphNodeAsFailed(typeGraphNode)
+			return reflect.Value{}, err
+		}
+	}
+
+	markGraphNodeAsUsed(typeGraphNode)
 
 	return res, nil
 }
 
+// override registers a provider which replaces the providers and supplied
+// values of its output types, regardless of whether they are registered
+// before or after it.
+func (c *container) override(provider *ProviderDescriptor) error {
+	for _, out := range provider.Outputs {
+		typ := out.Type
+		if isGroupedType(typ) {
+			return errors.Errorf("%v can't be overridden by %s because it is an auto-group or one-per-module type",
+				typ, provider.Location)
+		}
+
+		if c.overridden[typ] {
+			return errors.Errorf("duplicate override of type %v by %s", typ, provider.Location)
+		}
+
+		if existing, ok := c.resolvers[typ]; ok {
+			c.logf("Overriding %v provided by %s", typ, existing.describeLocation())
+			delete(c.resolvers, typ)
+		}
+	}
+
+	_, err := c.addNode(provider, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, out := range provider.Outputs {
+		c.overridden[out.Type] = true
+	}
+
+	return nil
+}
+
 func (c *container) run(invoker interface{}) error {
 	rctr, err := ExtractProviderDescriptor(invoker)
 	if err != nil {
@@ -397,6 +486,62 @@ func (c container) formatResolveStack() string {
 	return buf.String()
 }
 
+// formatCycle formats the chain of providers which leads from the provider at
+// loc back to itself using the current resolve stack.
+func (c container) formatCycle(loc Location) string {
+	start := len(c.resolveStack)
+	for i, frame := range c.resolveStack {
+		if frame.loc == loc {
+			start = i
+			break
+		}
+	}
+
+	buf := &bytes.Buffer{}
+	for _, frame := range c.resolveStack[start:] {
+		_, _ = fmt.Fprintf(buf, "\t%v\n\t\tdepends on %v provided by\n", frame.loc, frame.typ)
+	}
+	_, _ = fmt.Fprintf(buf, "\t%v", loc)
+	return buf.String()
+}
+
+// highlightFailingPath highlights the chain of providers and types in the
+// current resolve stack in the graph of the container. failing is the location
+// of the provider which failed or nil if the last type in the stack couldn't be
+// resolved.
+func (c *container) highlightFailingPath(failing Location) {
+	var provider *cgraph.Node
+	if failing != nil {
+		var err error
+		provider, err = c.locationGraphNode(failing, nil)
+		if err != nil {
+			return
+		}
+	}
+
+	for i := len(c.resolveStack) - 1; i >= 0; i-- {
+		frame := c.resolveStack[i]
+		typeGraphNode, err := c.typeGraphNode(frame.typ)
+		if err != nil {
+			return
+		}
+
+		callerGraphNode, err := c.locationGraphNode(frame.loc, nil)
+		if err != nil {
+			return
+		}
+
+		markGraphNodeAsFailed(typeGraphNode)
+		markGraphNodeAsFailed(callerGraphNode)
+		if provider != nil {
+			c.markGraphEdgeAsFailed(provider, typeGraphNode)
+		}
+		c.markGraphEdgeAsFailed(typeGraphNode, callerGraphNode)
+
+		provider = callerGraphNode
+	}
+}
+
 func markGraphNodeAsUsed(node *cgraph.Node) {
 	node.SetColor("black")
 }
@@ -404,3 +549,7 @@ func markGraphNodeAsUsed(node *cgraph.Node) {
 func markGraphNodeAsFailed(node *cgraph.Node) {
 	node.SetColor("red")
 }
+
+func markGraphNodeAsOptional(node *cgraph.Node) {
+	node.SetStyle(cgraph.DashedNodeStyle)
+}
@@ -141,13 +141,37 @@ func TestResolveError(t *testing.T) {
 }
 
 func TestCyclic(t *testing.T) {
-	require.Error(t, container.Run(
+	err := container.Run(
 		func(x string) {},
 		container.Provide(
 			func(x int) float64 { return float64(x) },
 			func(x float64) (int, string) { return int(x), "hi" },
 		),
+	)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "cyclic dependency")
+	require.Contains(t, err.Error(), "TestCyclic.func2 (")
+	require.Contains(t, err.Error(), "depends on float64 provided by")
+	require.Contains(t, err.Error(), "TestCyclic.func3 (")
+	require.Contains(t, err.Error(), "depends on int provided by")
+	require.Contains(t, err.Error(), "container_test.go:")
+}
+
+func TestFailingPathGraph(t *testing.T) {
+	var dotGraph string
+	require.Error(t, container.RunDebug(
+		func(x string) {},
+		container.Visualizer(func(g string) {
+			dotGraph = g
+		}),
+		container.Provide(
+			func(x float64) string { return fmt.Sprintf("%f", x) },
+			func(x int) float64 { return float64(x) },
+		),
 	))
+
+	require.Contains(t, dotGraph, "penwidth=2")
+	require.Contains(t, dotGraph, "color=red")
 }
 
 func TestErrorOption(t *testing.T) {
@@ -213,6 +237,18 @@ func TestSimple(t *testing.T) {
 			),
 		),
 	)
+
+	require.NoError(t,
+		container.Run(
+			func(x int, y string) {
+				require.Equal(t, 1, x)
+				require.Equal(t, "a", y)
+			},
+			container.Provide(
+				func() (int, string) { return 1, "a" },
+			),
+		),
+	)
 }
 
 func TestModuleScoped(t *testing.T) {
@@ -450,6 +486,166 @@ func TestSupply(t *testing.T) {
 	)
 }
 
+type Printer interface {
+	Print() string
+}
+
+type basePrinter struct{}
+
+func (basePrinter) Print() string { return "base" }
+
+type prefixPrinter struct {
+	prefix string
+	Printer
+}
+
+func (p prefixPrinter) Print() string { return p.prefix + p.Printer.Print() }
+
+func TestDecorate(t *testing.T) {
+	require.NoError(t,
+		container.Run(
+			func(p Printer) {
+				require.Equal(t, "b:a:base", p.Print())
+			},
+			container.Decorate(
+				func(p Printer) Printer { return prefixPrinter{"a:", p} },
+				func(p Printer, prefix string) Printer { return prefixPrinter{prefix, p} },
+			),
+			container.Provide(
+				func() Printer { return basePrinter{} },
+				func() string { return "b:" },
+			),
+		),
+	)
+
+	// decorators are only called once
+	calls := 0
+	require.NoError(t,
+		container.Run(
+			func(x int, y float64) {
+				require.Equal(t, 2, x)
+				require.Equal(t, 2.0, y)
+				require.Equal(t, 1, calls)
+			},
+			container.Supply(1),
+			container.Provide(func(x int) float64 { return float64(x) }),
+			container.Decorate(func(x int) int {
+				calls++
+				return x * 2
+			}),
+		),
+	)
+
+	// module-scoped values are decorated for each module
+	require.NoError(t,
+		container.Run(
+			func(a ModuleA, b ModuleB) {},
+			container.Provide(ProvideKVStoreKey),
+			container.Decorate(func(key KVStoreKey) KVStoreKey {
+				return KVStoreKey{name: key.name + "-decorated"}
+			}),
+			container.ProvideInModule("a", func(key KVStoreKey) ModuleA {
+				require.Equal(t, "a-decorated", key.name)
+				return ModuleA{}
+			}),
+			container.ProvideInModule("b", func(key KVStoreKey) ModuleB {
+				require.Equal(t, "b-decorated", key.name)
+				return ModuleB{}
+			}),
+		),
+	)
+
+	require.Error(t,
+		container.Run(func(int) {},
+			container.Supply(1),
+			container.Decorate(func(x int) (int, string) { return x, "" }),
+		),
+		"decorator with several outputs",
+	)
+
+	require.Error(t,
+		container.Run(func(int) {},
+			container.Supply(1),
+			container.Decorate(func(x float64) int { return int(x) }),
+		),
+		"decorator without decorated input",
+	)
+
+	require.Error(t,
+		container.Run(func([]AutoGroupInt) {},
+			container.Decorate(func(x []AutoGroupInt) []AutoGroupInt { return x }),
+		),
+		"decorate auto-group type",
+	)
+
+	require.Error(t,
+		container.Run(func(int) {},
+			container.Supply(1),
+			container.Decorate(func(_ container.ModuleKey, x int) int { return x }),
+		),
+		"module-scoped decorator",
+	)
+
+	err := container.Run(func(int) {},
+		container.Provide(func(x float64) int { return int(x) }),
+		container.Decorate(func(x float64, y int) float64 { return x + float64(y) }),
+		container.Supply(1.0),
+	)
+	require.Error(t, err, "cyclic decorator")
+	require.Contains(t, err.Error(), "cyclic dependency")
+}
+
+func TestOverride(t *testing.T) {
+	require.NoError(t,
+		container.Run(
+			func(x int, y string) {
+				require.Equal(t, 2, x)
+				require.Equal(t, "a", y)
+			},
+			container.Override(func() int { return 2 }),
+			container.Provide(func() (int, string) { return 1, "a" }),
+		),
+		"override before provide",
+	)
+
+	require.NoError(t,
+		container.Run(
+			func(x int) {
+				require.Equal(t, 2, x)
+			},
+			container.Supply(1),
+			container.Override(func() int { return 2 }),
+		),
+		"override after supply",
+	)
+
+	require.NoError(t,
+		container.Run(
+			func(key KVStoreKey) {
+				require.Equal(t, "mock", key.name)
+			},
+			container.Provide(ProvideKVStoreKey),
+			container.Override(func() KVStoreKey { return KVStoreKey{name: "mock"} }),
+		),
+		"override module-scoped provider",
+	)
+
+	require.Error(t,
+		container.Run(func(int) {},
+			container.Override(func() int { return 2 }),
+			container.Override(func() int { return 3 }),
+		),
+		"duplicate override",
+	)
+
+	require.Error(t,
+		container.Run(func([]AutoGroupInt) {},
+			container.Override(func() AutoGroupInt { return 0 }),
+		),
+		"override auto-group type",
+	)
+}
+
 type TestInput struct {
 	container.In
 
@@ -98,10 +98,16 @@ type debugConfig struct {
 	// graphing
 	graphviz      *graphviz.Graphviz
 	graph         *cgraph.Graph
+	graphNodes    map[string]*cgraph.Node
+	graphEdges    map[graphEdgeKey]*cgraph.Edge
 	visualizers   []func(string)
 	logVisualizer bool
 }
 
+type graphEdgeKey struct {
+	from, to *cgraph.Node
+}
+
 type debugOption func(*debugConfig) error
 
 func (c debugOption) applyConfig(ctr *debugConfig) error {
@@ -118,8 +124,10 @@ func newDebugConfig() (*debugConfig, error) {
 	}
 
 	return &debugConfig{
-		graphviz: g,
-		graph:    graph,
+		graphviz:   g,
+		graph:      graph,
+		graphNodes: map[string]*cgraph.Node{},
+		graphEdges: map[graphEdgeKey]*cgraph.Edge{},
 	}, nil
 }
 
@@ -224,12 +232,9 @@ func (c *debugConfig) typeGraphNode(typ reflect.Type) (*cgraph.Node, error) {
 }
 
 func (c *debugConfig) findOrCreateGraphNode(subGraph *cgraph.Graph, name string) (node *cgraph.Node, found bool, err error) {
-	node, err = c.graph.Node(name)
-	if err != nil {
-		return nil, false, errors.Wrapf(err, "error finding graph node %s", name)
-	}
-
-	if node != nil {
+	// nodes are cached so that the same *cgraph.Node is always returned for
+	// a name which allows edges to be looked up by their nodes
+	if node, ok := c.graphNodes[name]; ok {
 		return node, true, nil
 	}
 
@@ -238,6 +243,7 @@ func (c *debugConfig) findOrCreateGraphNode(subGraph *cgraph.Graph, name string)
 		return nil, false, errors.Wrapf(err, "error creating graph node %s", name)
 	}
 
+	c.graphNodes[name] = node
 	return node, false, nil
 }
 
@@ -252,8 +258,27 @@ func (c *debugConfig) moduleSubGraph(key *moduleKey) *cgraph.Graph {
 }
 
 func (c *debugConfig) addGraphEdge(from *cgraph.Node, to *cgraph.Node) {
-	_, err := c.graph.CreateEdge("", from, to)
+	key := graphEdgeKey{from: from, to: to}
+	if _, ok := c.graphEdges[key]; ok {
+		return
+	}
+
+	edge, err := c.graph.CreateEdge("", from, to)
 	if err != nil {
 		c.logf("error creating graph edge")
+		return
 	}
+
+	c.graphEdges[key] = edge
+}
+
+// markGraphEdgeAsFailed highlights the edge between two nodes if there is one.
+func (c *debugConfig) markGraphEdgeAsFailed(from *cgraph.Node, to *cgraph.Node) {
+	edge, ok := c.graphEdges[graphEdgeKey{from: from, to: to}]
+	if !ok {
+		return
+	}
+
+	edge.SetColor("red")
+	edge.SetPenWidth(2)
 }
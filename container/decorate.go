@@ -0,0 +1,105 @@
+package container
+
+import (
+	"reflect"
+
+	"github.com/pkg/errors"
+)
+
+// decorator is a provider which takes a value of the type it decorates as one
+// of its inputs and returns a new value of that type which replaces the
+// original value for all of the type's dependents.
+type decorator struct {
+	provider *ProviderDescriptor
+
+	// idxInInputs is the index of the input receiving the value to decorate.
+	idxInInputs int
+}
+
+func isGroupedType(typ reflect.Type) bool {
+	return isAutoGroupType(typ) || isAutoGroupSliceType(typ) ||
+		isOnePerModuleType(typ) || isOnePerModuleMapType(typ)
+}
+
+func (c *container) addDecorator(provider *ProviderDescriptor) error {
+	loc := provider.Location
+	if len(provider.Outputs) != 1 {
+		return errors.Errorf("decorator %s must return exactly one value, optionally followed by an error", loc)
+	}
+
+	typ := provider.Outputs[0].Type
+	if isGroupedType(typ) {
+		return errors.Errorf("decorator %s can't decorate %v which is an auto-group or one-per-module type", loc, typ)
+	}
+
+	idx := -1
+	for i, in := range provider.Inputs {
+		if in.Type == moduleKeyType {
+			return errors.Errorf("decorator %s can't take a ModuleKey input", loc)
+		}
+
+		if in.Type == typ {
+			if idx >= 0 {
+				return errors.Errorf("decorator %s takes %v as an input more than once", loc, typ)
+			}
+			idx = i
+		}
+	}
+
+	if idx < 0 {
+		return errors.Errorf("decorator %s must take the type it decorates, %v, as an input", loc, typ)
+	}
+
+	c.logf("Registering decorator %s for %v", loc, typ)
+
+	decoratorGraphNode, err := c.locationGraphNode(loc, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, in := range provider.Inputs {
+		typeGraphNode, err := c.typeGraphNode(in.Type)
+		if err != nil {
+			return err
+		}
+		c.addGraphEdge(typeGraphNode, decoratorGraphNode)
+	}
+
+	typeGraphNode, err := c.typeGraphNode(typ)
+	if err != nil {
+		return err
+	}
+	c.addGraphEdge(decoratorGraphNode, typeGraphNode)
+
+	c.decorators[typ] = append(c.decorators[typ], &decorator{
+		provider:    provider,
+		idxInInputs: idx,
+	})
+
+	return nil
+}
+
+// decorate applies the decorators registered for typ, in the order in which
+// they were registered, to the value of typ resolved for the module key. The
+// module key is nil unless typ is provided by a module-scoped provider.
+func (c *container) decorate(typ reflect.Type, value reflect.Value, key *moduleKey) (reflect.Value, error) {
+	if decorated, ok := c.decorated[typ][key]; ok {
+		return decorated, nil
+	}
+
+	for _, d := range c.decorators[typ] {
+		c.logf("Decorating %v with %s", typ, d.provider.Location)
+		out, err := c.callWithInputs(d.provider, key, map[int]reflect.Value{d.idxInInputs: value})
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		value = out[0]
+	}
+
+	if c.decorated[typ] == nil {
+		c.decorated[typ] = map[*moduleKey]reflect.Value{}
+	}
+	c.decorated[typ][key] = value
+
+	return value, nil
+}
@@ -34,6 +34,54 @@ func ProvideInModule(moduleName string, providers ...interface{}) Option {
 	})
 }
 
+// Decorate creates a container option which registers the provided decorators.
+// A decorator is a function which takes the value of the type it returns as one
+// of its inputs, along with any other dependencies, and returns a new value of
+// that type, for instance to wrap a value provided by another module. All of
+// the type's dependents receive the decorated value. Several decorators of the
+// same type are applied in the order in which they are registered. Values of
+// types provided by module-scoped providers are decorated separately for each
+// module. Decorators can't decorate auto-group or one-per-module types.
+func Decorate(decorators ...interface{}) Option {
+	return containerOption(func(ctr *container) error {
+		for _, d := range decorators {
+			rd, err := ExtractProviderDescriptor(d)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			err = ctr.addDecorator(&rd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+		}
+		return nil
+	})
+}
+
+// Override creates a container option which registers the provided providers
+// in place of any other providers or supplied values of their output types,
+// regardless of whether the other providers are registered before or after
+// them. It is intended for replacing dependencies with mocks in tests. Each
+// type can only be overridden once and auto-group and one-per-module types
+// can't be overridden.
+func Override(providers ...interface{}) Option {
+	return containerOption(func(ctr *container) error {
+		for _, c := range providers {
+			rc, err := ExtractProviderDescriptor(c)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			err = ctr.override(&rc)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+		}
+		return nil
+	})
+}
+
 func provide(ctr *container, key *moduleKey, providers []interface{}) error {
 	for _, c := range providers {
 		rc, err := ExtractProviderDescriptor(c)
@@ -5,7 +5,7 @@ import (
 
 	"google.golang.org/protobuf/proto"
 
-	v1alpha1 "github.com/cosmos/cosmos-sdk/api/cosmos/orm/v1alpha1"
+	ormv1 "github.com/cosmos/cosmos-sdk/api/cosmos/orm/v1"
 
 	"github.com/cosmos/cosmos-proto/generator"
 
@@ -48,11 +48,11 @@ func PluginRunner(p *protogen.Plugin) error {
 
 func hasTables(file *protogen.File) bool {
 	for _, message := range file.Messages {
-		if proto.GetExtension(message.Desc.Options(), v1alpha1.E_Table).(*v1alpha1.TableDescriptor) != nil {
+		if proto.GetExtension(message.Desc.Options(), ormv1.E_Table).(*ormv1.TableDescriptor) != nil {
 			return true
 		}
 
-		if proto.GetExtension(message.Desc.Options(), v1alpha1.E_Singleton).(*v1alpha1.SingletonDescriptor) != nil {
+		if proto.GetExtension(message.Desc.Options(), ormv1.E_Singleton).(*ormv1.SingletonDescriptor) != nil {
 			return true
 		}
 	}
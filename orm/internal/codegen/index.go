@@ -24,6 +24,9 @@ func (t tableGen) genIndexKeys() {
 	for _, idx := range t.table.Index {
 		t.genIndex(idx.Fields, idx.Id, false)
 	}
+	if ttl := t.table.Ttl; ttl != nil {
+		t.genIndex(ttl.ExpirationField, ttl.IndexId, false)
+	}
 }
 
 func (t tableGen) genIterator() {
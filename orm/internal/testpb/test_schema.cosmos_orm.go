@@ -680,12 +680,145 @@ func NewSimpleExampleTable(db ormtable.Schema) (SimpleExampleTable, error) {
 	return simpleExampleTable{table}, nil
 }
 
+type ExampleTTLTable interface {
+	Insert(ctx context.Context, exampleTTL *ExampleTTL) error
+	InsertReturningID(ctx context.Context, exampleTTL *ExampleTTL) (uint64, error)
+	Update(ctx context.Context, exampleTTL *ExampleTTL) error
+	Save(ctx context.Context, exampleTTL *ExampleTTL) error
+	Delete(ctx context.Context, exampleTTL *ExampleTTL) error
+	Has(ctx context.Context, id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, id uint64) (*ExampleTTL, error)
+	List(ctx context.Context, prefixKey ExampleTTLIndexKey, opts ...ormlist.Option) (ExampleTTLIterator, error)
+	ListRange(ctx context.Context, from, to ExampleTTLIndexKey, opts ...ormlist.Option) (ExampleTTLIterator, error)
+	DeleteBy(ctx context.Context, prefixKey ExampleTTLIndexKey) error
+	DeleteRange(ctx context.Context, from, to ExampleTTLIndexKey) error
+
+	doNotImplement()
+}
+
+type ExampleTTLIterator struct {
+	ormtable.Iterator
+}
+
+func (i ExampleTTLIterator) Value() (*ExampleTTL, error) {
+	var exampleTTL ExampleTTL
+	err := i.UnmarshalMessage(&exampleTTL)
+	return &exampleTTL, err
+}
+
+type ExampleTTLIndexKey interface {
+	id() uint32
+	values() []interface{}
+	exampleTTLIndexKey()
+}
+
+// primary key starting index..
+type ExampleTTLPrimaryKey = ExampleTTLIdIndexKey
+
+type ExampleTTLIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x ExampleTTLIdIndexKey) id() uint32            { return 0 }
+func (x ExampleTTLIdIndexKey) values() []interface{} { return x.vs }
+func (x ExampleTTLIdIndexKey) exampleTTLIndexKey()   {}
+
+func (this ExampleTTLIdIndexKey) WithId(id uint64) ExampleTTLIdIndexKey {
+	this.vs = []interface{}{id}
+	return this
+}
+
+type ExampleTTLExpirationIndexKey struct {
+	vs []interface{}
+}
+
+func (x ExampleTTLExpirationIndexKey) id() uint32            { return 1 }
+func (x ExampleTTLExpirationIndexKey) values() []interface{} { return x.vs }
+func (x ExampleTTLExpirationIndexKey) exampleTTLIndexKey()   {}
+
+func (this ExampleTTLExpirationIndexKey) WithExpiration(expiration *timestamppb.Timestamp) ExampleTTLExpirationIndexKey {
+	this.vs = []interface{}{expiration}
+	return this
+}
+
+type exampleTTLTable struct {
+	table ormtable.AutoIncrementTable
+}
+
+func (this exampleTTLTable) Insert(ctx context.Context, exampleTTL *ExampleTTL) error {
+	return this.table.Insert(ctx, exampleTTL)
+}
+
+func (this exampleTTLTable) Update(ctx context.Context, exampleTTL *ExampleTTL) error {
+	return this.table.Update(ctx, exampleTTL)
+}
+
+func (this exampleTTLTable) Save(ctx context.Context, exampleTTL *ExampleTTL) error {
+	return this.table.Save(ctx, exampleTTL)
+}
+
+func (this exampleTTLTable) Delete(ctx context.Context, exampleTTL *ExampleTTL) error {
+	return this.table.Delete(ctx, exampleTTL)
+}
+
+func (this exampleTTLTable) InsertReturningID(ctx context.Context, exampleTTL *ExampleTTL) (uint64, error) {
+	return this.table.InsertReturningID(ctx, exampleTTL)
+}
+
+func (this exampleTTLTable) Has(ctx context.Context, id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, id)
+}
+
+func (this exampleTTLTable) Get(ctx context.Context, id uint64) (*ExampleTTL, error) {
+	var exampleTTL ExampleTTL
+	found, err := this.table.PrimaryKey().Get(ctx, &exampleTTL, id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &exampleTTL, nil
+}
+
+func (this exampleTTLTable) List(ctx context.Context, prefixKey ExampleTTLIndexKey, opts ...ormlist.Option) (ExampleTTLIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return ExampleTTLIterator{it}, err
+}
+
+func (this exampleTTLTable) ListRange(ctx context.Context, from, to ExampleTTLIndexKey, opts ...ormlist.Option) (ExampleTTLIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return ExampleTTLIterator{it}, err
+}
+
+func (this exampleTTLTable) DeleteBy(ctx context.Context, prefixKey ExampleTTLIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this exampleTTLTable) DeleteRange(ctx context.Context, from, to ExampleTTLIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this exampleTTLTable) doNotImplement() {}
+
+var _ ExampleTTLTable = exampleTTLTable{}
+
+func NewExampleTTLTable(db ormtable.Schema) (ExampleTTLTable, error) {
+	table := db.GetTable(&ExampleTTL{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&ExampleTTL{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return exampleTTLTable{table.(ormtable.AutoIncrementTable)}, nil
+}
+
 type TestSchemaStore interface {
 	ExampleTableTable() ExampleTableTable
 	ExampleAutoIncrementTableTable() ExampleAutoIncrementTableTable
 	ExampleSingletonTable() ExampleSingletonTable
 	ExampleTimestampTable() ExampleTimestampTable
 	SimpleExampleTable() SimpleExampleTable
+	ExampleTTLTable() ExampleTTLTable
 
 	doNotImplement()
 }
@@ -696,6 +829,7 @@ type testSchemaStore struct {
 	exampleSingleton          ExampleSingletonTable
 	exampleTimestamp          ExampleTimestampTable
 	simpleExample             SimpleExampleTable
+	exampleTTL                ExampleTTLTable
 }
 
 func (x testSchemaStore) ExampleTableTable() ExampleTableTable {
@@ -718,6 +852,10 @@ func (x testSchemaStore) SimpleExampleTable() SimpleExampleTable {
 	return x.simpleExample
 }
 
+func (x testSchemaStore) ExampleTTLTable() ExampleTTLTable {
+	return x.exampleTTL
+}
+
 func (testSchemaStore) doNotImplement() {}
 
 var _ TestSchemaStore = testSchemaStore{}
@@ -748,11 +886,17 @@ func NewTestSchemaStore(db ormtable.Schema) (TestSchemaStore, error) {
 		return nil, err
 	}
 
+	exampleTTLTable, err := NewExampleTTLTable(db)
+	if err != nil {
+		return nil, err
+	}
+
 	return testSchemaStore{
 		exampleTableTable,
 		exampleAutoIncrementTableTable,
 		exampleSingletonTable,
 		exampleTimestampTable,
 		simpleExampleTable,
+		exampleTTLTable,
 	}, nil
 }
@@ -41,6 +41,13 @@ type ModuleDB interface {
 
 	// ExportJSON exports JSON for each table in the module.
 	ExportJSON(context.Context, ormjson.WriteTarget) error
+
+	// PruneExpired deletes expired entries from each table in the module which
+	// declares a TTL. It is intended to be called in BeginBlock or EndBlock.
+	// Tables are pruned in file ID and then table ID order with the gas
+	// budget shared between all tables, so that the entries deleted in a
+	// block are deterministic.
+	PruneExpired(context.Context, ormtable.PruneExpiredOptions) (ormtable.PruneExpiredResult, error)
 }
 
 type moduleDB struct {
@@ -7,10 +7,12 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	ormv1alpha1 "github.com/cosmos/cosmos-sdk/api/cosmos/orm/v1alpha1"
 
 	"github.com/golang/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/cosmos/cosmos-sdk/orm/testing/ormmocks"
 
@@ -367,3 +369,37 @@ func TestGetBackendResolver(t *testing.T) {
 	})
 	assert.NilError(t, err)
 }
+
+func TestPruneExpired(t *testing.T) {
+	db, err := ormdb.NewModuleDB(&ormv1alpha1.ModuleSchemaDescriptor{SchemaFile: []*ormv1alpha1.ModuleSchemaDescriptor_FileEntry{
+		{Id: 1, ProtoFileName: testpb.File_testpb_bank_proto.Path()},
+		{Id: 2, ProtoFileName: testpb.File_testpb_test_schema_proto.Path()},
+	}}, ormdb.ModuleDBOptions{})
+	assert.NilError(t, err)
+
+	store, err := testpb.NewTestSchemaStore(db)
+	assert.NilError(t, err)
+
+	ctx := ormtable.WrapContextDefault(testkv.NewSplitMemBackend())
+	now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 1; i <= 3; i++ {
+		assert.NilError(t, store.ExampleTTLTable().Insert(ctx, &testpb.ExampleTTL{
+			Expiration: timestamppb.New(now.Add(time.Duration(-i) * time.Hour)),
+		}))
+	}
+
+	// the gas budget is shared between tables
+	res, err := db.PruneExpired(ctx, ormtable.PruneExpiredOptions{
+		BlockTime: now,
+		GasBudget: 2 * ormtable.DefaultExpiryGasCost,
+	})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, ormtable.PruneExpiredResult{Pruned: 2, GasUsed: 2 * ormtable.DefaultExpiryGasCost}, res)
+
+	res, err = db.PruneExpired(ctx, ormtable.PruneExpiredOptions{
+		BlockTime: now,
+		GasBudget: 2 * ormtable.DefaultExpiryGasCost,
+	})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, ormtable.PruneExpiredResult{Pruned: 1, GasUsed: ormtable.DefaultExpiryGasCost, Done: true}, res)
+}
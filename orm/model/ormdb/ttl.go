@@ -0,0 +1,55 @@
+package ormdb
+
+import (
+	"context"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormtable"
+)
+
+func (m moduleDB) PruneExpired(ctx context.Context, options ormtable.PruneExpiredOptions) (ormtable.PruneExpiredResult, error) {
+	res := ormtable.PruneExpiredResult{Done: true}
+	budget := options.GasBudget
+
+	// files and then tables are visited in ID order so that pruning is deterministic
+	fileIds := make([]uint32, 0, len(m.filesById))
+	for id := range m.filesById {
+		fileIds = append(fileIds, id)
+	}
+	sort.Slice(fileIds, func(i, j int) bool { return fileIds[i] < fileIds[j] })
+
+	for _, fileId := range fileIds {
+		file := m.filesById[fileId]
+		tableIds := make([]uint32, 0, len(file.tablesById))
+		for id := range file.tablesById {
+			tableIds = append(tableIds, id)
+		}
+		sort.Slice(tableIds, func(i, j int) bool { return tableIds[i] < tableIds[j] })
+
+		for _, tableId := range tableIds {
+			table := file.tablesById[tableId]
+			if table.ExpirationIndex() == nil {
+				continue
+			}
+
+			tableOpts := options
+			if budget != 0 {
+				tableOpts.GasBudget = budget - res.GasUsed
+			}
+
+			tableRes, err := table.PruneExpired(ctx, tableOpts)
+			res.Pruned += tableRes.Pruned
+			res.GasUsed += tableRes.GasUsed
+			if err != nil {
+				return res, err
+			}
+
+			if !tableRes.Done {
+				res.Done = false
+				return res, nil
+			}
+		}
+	}
+
+	return res, nil
+}
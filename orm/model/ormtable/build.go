@@ -11,12 +11,15 @@ import (
 
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
-	ormv1alpha1 "github.com/cosmos/cosmos-sdk/api/cosmos/orm/v1alpha1"
+	ormv1 "github.com/cosmos/cosmos-sdk/api/cosmos/orm/v1"
 	"github.com/cosmos/cosmos-sdk/orm/encoding/ormkv"
 	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
 )
 
+var timestampFullName = (&timestamppb.Timestamp{}).ProtoReflect().Descriptor().FullName()
+
 const (
 	primaryKeyId uint32 = 0
 	indexIdLimit uint32 = 32768
@@ -35,13 +38,13 @@ type Options struct {
 	// with the table. Generally this should be nil and the table descriptor
 	// should be pulled from the table message option. TableDescriptor
 	// cannot be used together with SingletonDescriptor.
-	TableDescriptor *ormv1alpha1.TableDescriptor
+	TableDescriptor *ormv1.TableDescriptor
 
 	// SingletonDescriptor is an optional singleton descriptor to be explicitly used.
 	// Generally this should be nil and the table descriptor
 	// should be pulled from the singleton message option. SingletonDescriptor
 	// cannot be used together with TableDescriptor.
-	SingletonDescriptor *ormv1alpha1.SingletonDescriptor
+	SingletonDescriptor *ormv1.SingletonDescriptor
 
 	// TypeResolver is an optional type resolver to be used when unmarshaling
 	// protobuf messages.
@@ -96,12 +99,12 @@ func Build(options Options) (Table, error) {
 
 	tableDesc := options.TableDescriptor
 	if tableDesc == nil {
-		tableDesc = proto.GetExtension(messageDescriptor.Options(), ormv1alpha1.E_Table).(*ormv1alpha1.TableDescriptor)
+		tableDesc = proto.GetExtension(messageDescriptor.Options(), ormv1.E_Table).(*ormv1.TableDescriptor)
 	}
 
 	singletonDesc := options.SingletonDescriptor
 	if singletonDesc == nil {
-		singletonDesc = proto.GetExtension(messageDescriptor.Options(), ormv1alpha1.E_Singleton).(*ormv1alpha1.SingletonDescriptor)
+		singletonDesc = proto.GetExtension(messageDescriptor.Options(), ormv1.E_Singleton).(*ormv1.SingletonDescriptor)
 	}
 
 	switch {
@@ -173,7 +176,27 @@ func Build(options Options) (Table, error) {
 	table.indexesById[primaryKeyId] = pkIndex
 	table.indexes = append(table.indexes, pkIndex)
 
-	for _, idxDesc := range tableDesc.Index {
+	idxDescs := tableDesc.Index
+	var ttlField protoreflect.FieldDescriptor
+	if ttlDesc := tableDesc.Ttl; ttlDesc != nil {
+		ttlField = messageDescriptor.Fields().ByName(protoreflect.Name(ttlDesc.ExpirationField))
+		if ttlField == nil {
+			return nil, ormerrors.FieldNotFound.Wrapf("ttl expiration field %s on table %s", ttlDesc.ExpirationField, messageDescriptor.FullName())
+		}
+
+		if ttlField.Kind() != protoreflect.MessageKind || ttlField.IsList() ||
+			ttlField.Message().FullName() != timestampFullName {
+			return nil, ormerrors.InvalidTableDefinition.Wrapf("ttl expiration field %s must be a google.protobuf.Timestamp", ttlField.FullName())
+		}
+
+		// the expiration index is generated as a regular non-unique secondary index
+		idxDescs = append(append([]*ormv1.SecondaryIndexDescriptor{}, idxDescs...), &ormv1.SecondaryIndexDescriptor{
+			Fields: ttlDesc.ExpirationField,
+			Id:     ttlDesc.IndexId,
+		})
+	}
+
+	for _, idxDesc := range idxDescs {
 		id := idxDesc.Id
 		if id == 0 || id >= indexIdLimit {
 			return nil, ormerrors.InvalidIndexId.Wrapf("index on table %s with fields %s, invalid id %d", messageDescriptor.FullName(), idxDesc.Fields, id)
@@ -270,6 +293,10 @@ func Build(options Options) (Table, error) {
 		table.indexesById[id] = index
 		table.indexes = append(table.indexes, index)
 		table.indexers = append(table.indexers, index.(indexer))
+
+		if ttlField != nil && id == tableDesc.Ttl.IndexId {
+			table.expirationIndex = index
+		}
 	}
 
 	if tableDesc.PrimaryKey.AutoIncrement {
@@ -40,3 +40,12 @@ type WriteHooks interface {
 	// OnDelete is called after the entity is deleted from the store.
 	OnDelete(context.Context, proto.Message)
 }
+
+// ExpiryHooks defines an interface for listening to the automatic expiry of
+// entries in tables which declare a TTL.
+type ExpiryHooks interface {
+
+	// OnExpire is called before an expired message is deleted from the store.
+	// If error is not nil pruning will stop and the error will be returned.
+	OnExpire(context.Context, proto.Message) error
+}
@@ -138,6 +138,17 @@ type Table interface {
 	// ID is the ID of this table within the schema of its FileDescriptor.
 	ID() uint32
 
+	// ExpirationIndex returns the index generated over the table's TTL
+	// expiration field or nil if the table doesn't declare a TTL.
+	ExpirationIndex() Index
+
+	// PruneExpired deletes entries whose expiration time is at or before
+	// the block time specified in the options. Entries are deleted in ascending
+	// expiration time order (and then primary key order) until either no expired
+	// entries remain or the gas budget is exhausted. Tables without a TTL
+	// never have any entries pruned.
+	PruneExpired(ctx context.Context, options PruneExpiredOptions) (PruneExpiredResult, error)
+
 	Schema
 }
 
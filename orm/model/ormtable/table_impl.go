@@ -30,6 +30,7 @@ type tableImpl struct {
 	tableId               uint32
 	typeResolver          TypeResolver
 	customJSONValidator   func(message proto.Message) error
+	expirationIndex       concreteIndex
 }
 
 func (t *tableImpl) GetTable(message proto.Message) Table {
@@ -0,0 +1,138 @@
+package ormtable
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// DefaultExpiryGasCost is the gas charged for each expired entry when
+// PruneExpiredOptions.GasCost is nil.
+const DefaultExpiryGasCost uint64 = 1000
+
+// PruneExpiredOptions are options for pruning expired table entries.
+type PruneExpiredOptions struct {
+
+	// BlockTime is the current block time. Entries which have an expiration time
+	// at or before BlockTime are considered expired.
+	BlockTime time.Time
+
+	// GasBudget is the maximum amount of gas which may be consumed by pruning.
+	// Pruning stops before the first entry which would exceed the budget. If it
+	// is zero, all expired entries will be pruned.
+	GasBudget uint64
+
+	// GasCost is an optional function which returns the gas cost of pruning an
+	// expired message. If it is nil, DefaultExpiryGasCost is charged for each
+	// entry.
+	GasCost func(proto.Message) uint64
+
+	// Hooks are optional hooks which are called for each expired entry before
+	// it is deleted.
+	Hooks ExpiryHooks
+}
+
+// PruneExpiredResult is the result of pruning expired table entries.
+type PruneExpiredResult struct {
+
+	// Pruned is the number of entries which were deleted.
+	Pruned uint64
+
+	// GasUsed is the amount of gas consumed by the pruned entries.
+	GasUsed uint64
+
+	// Done is false if pruning stopped because the gas budget was exhausted
+	// and expired entries may still remain.
+	Done bool
+}
+
+// minExpiration is the earliest expiration time which is considered when
+// pruning. Entries without an expiration time set are encoded in the index
+// with the zero timestamp and are thus never pruned.
+var minExpiration = &timestamppb.Timestamp{Nanos: 1}
+
+func (t tableImpl) ExpirationIndex() Index {
+	return t.expirationIndex
+}
+
+func (t tableImpl) PruneExpired(ctx context.Context, options PruneExpiredOptions) (PruneExpiredResult, error) {
+	if t.expirationIndex == nil {
+		return PruneExpiredResult{Done: true}, nil
+	}
+
+	backend, err := t.getWriteBackend(ctx)
+	if err != nil {
+		return PruneExpiredResult{}, err
+	}
+
+	it, err := t.expirationIndex.ListRange(ctx,
+		[]interface{}{minExpiration},
+		[]interface{}{timestamppb.New(options.BlockTime)},
+	)
+	if err != nil {
+		return PruneExpiredResult{}, err
+	}
+
+	// we batch writes while the iterator is still open
+	writer := newBatchIndexCommitmentWriter(backend)
+	defer writer.Close()
+
+	res, err := t.pruneByIterator(ctx, backend, writer, it, options)
+	// close iterator
+	it.Close()
+	if err != nil {
+		return res, err
+	}
+
+	// then write batch
+	return res, writer.Write()
+}
+
+func (t tableImpl) pruneByIterator(ctx context.Context, backend Backend, writer *batchIndexCommitmentWriter, it Iterator, options PruneExpiredOptions) (PruneExpiredResult, error) {
+	res := PruneExpiredResult{Done: true}
+	for it.Next() {
+		_, pk, err := it.Keys()
+		if err != nil {
+			return res, err
+		}
+
+		msg, err := it.GetMessage()
+		if err != nil {
+			return res, err
+		}
+
+		cost := DefaultExpiryGasCost
+		if options.GasCost != nil {
+			cost = options.GasCost(msg)
+		}
+
+		if options.GasBudget != 0 && res.GasUsed+cost > options.GasBudget {
+			res.Done = false
+			return res, nil
+		}
+
+		if options.Hooks != nil {
+			err = options.Hooks.OnExpire(ctx, msg)
+			if err != nil {
+				return res, err
+			}
+		}
+
+		pkBz, err := t.EncodeKey(pk)
+		if err != nil {
+			return res, err
+		}
+
+		err = t.doDeleteWithWriteBatch(ctx, backend, writer, pkBz, msg)
+		if err != nil {
+			return res, err
+		}
+
+		res.Pruned++
+		res.GasUsed += cost
+	}
+
+	return res, nil
+}
@@ -0,0 +1,158 @@
+package ormtable_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gotest.tools/v3/assert"
+
+	ormv1 "github.com/cosmos/cosmos-sdk/api/cosmos/orm/v1"
+	"github.com/cosmos/cosmos-sdk/orm/internal/testkv"
+	"github.com/cosmos/cosmos-sdk/orm/internal/testpb"
+	"github.com/cosmos/cosmos-sdk/orm/model/ormtable"
+	"github.com/cosmos/cosmos-sdk/orm/testing/ormmocks"
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+)
+
+func TestPruneExpired(t *testing.T) {
+	table, err := ormtable.Build(ormtable.Options{
+		MessageType: (&testpb.ExampleTTL{}).ProtoReflect().Type(),
+	})
+	assert.NilError(t, err)
+	assert.Assert(t, table.ExpirationIndex() != nil)
+	assert.Equal(t, "expiration", table.ExpirationIndex().Fields())
+
+	backend := testkv.NewSplitMemBackend()
+	ctx := ormtable.WrapContextDefault(backend)
+	store, err := testpb.NewExampleTTLTable(table)
+	assert.NilError(t, err)
+
+	now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	// inserted out of expiration order to check that pruning is ordered by expiration
+	for _, offset := range []time.Duration{3, 1, 4, 2, 5} {
+		assert.NilError(t, store.Insert(ctx, &testpb.ExampleTTL{
+			Name:       fmt.Sprintf("expires-%d", offset),
+			Expiration: timestamppb.New(now.Add(offset * time.Hour)),
+		}))
+	}
+	assert.NilError(t, store.Insert(ctx, &testpb.ExampleTTL{Name: "never"}))
+
+	// nothing has expired yet
+	res, err := table.PruneExpired(ctx, ormtable.PruneExpiredOptions{BlockTime: now})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, ormtable.PruneExpiredResult{Done: true}, res)
+
+	var expired []string
+	hooks := &expiryHooks{onExpire: func(message proto.Message) error {
+		expired = append(expired, message.(*testpb.ExampleTTL).Name)
+		return nil
+	}}
+
+	// the budget only allows two entries to be pruned
+	res, err = table.PruneExpired(ctx, ormtable.PruneExpiredOptions{
+		BlockTime: now.Add(4 * time.Hour),
+		GasBudget: 2*ormtable.DefaultExpiryGasCost + 1,
+		Hooks:     hooks,
+	})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, ormtable.PruneExpiredResult{Pruned: 2, GasUsed: 2 * ormtable.DefaultExpiryGasCost}, res)
+	assert.DeepEqual(t, []string{"expires-1", "expires-2"}, expired)
+
+	// expiration is inclusive of the block time
+	res, err = table.PruneExpired(ctx, ormtable.PruneExpiredOptions{
+		BlockTime: now.Add(4 * time.Hour),
+		GasCost:   func(proto.Message) uint64 { return 1 },
+		Hooks:     hooks,
+	})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, ormtable.PruneExpiredResult{Pruned: 2, GasUsed: 2, Done: true}, res)
+	assert.DeepEqual(t, []string{"expires-1", "expires-2", "expires-3", "expires-4"}, expired)
+
+	// entries without an expiration are never pruned
+	res, err = table.PruneExpired(ctx, ormtable.PruneExpiredOptions{
+		BlockTime: now.Add(100 * time.Hour),
+		Hooks:     hooks,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, uint64(1), res.Pruned)
+
+	it, err := store.List(ctx, testpb.ExampleTTLIdIndexKey{})
+	assert.NilError(t, err)
+	assert.Assert(t, it.Next())
+	remaining, err := it.Value()
+	assert.NilError(t, err)
+	assert.Equal(t, "never", remaining.Name)
+	assert.Assert(t, !it.Next())
+	it.Close()
+}
+
+func TestPruneExpiredHooks(t *testing.T) {
+	table, err := ormtable.Build(ormtable.Options{
+		MessageType: (&testpb.ExampleTTL{}).ProtoReflect().Type(),
+	})
+	assert.NilError(t, err)
+
+	ctrl := gomock.NewController(t)
+	writeHooks := ormmocks.NewMockWriteHooks(ctrl)
+	backend := testkv.NewSplitMemBackend().(ormtable.Backend).WithWriteHooks(writeHooks)
+	ctx := ormtable.WrapContextDefault(backend)
+
+	now := time.Now()
+	entry := &testpb.ExampleTTL{Name: "foo", Expiration: timestamppb.New(now)}
+	writeHooks.EXPECT().OnInsert(ctx, gomock.Any())
+	assert.NilError(t, table.Insert(ctx, entry))
+
+	// an error returned from the hooks aborts pruning and leaves the store unchanged
+	expiryHooks := ormmocks.NewMockExpiryHooks(ctrl)
+	expiryHooks.EXPECT().OnExpire(ctx, ormmocks.Eq(entry)).Return(fmt.Errorf("boom"))
+	_, err = table.PruneExpired(ctx, ormtable.PruneExpiredOptions{BlockTime: now, Hooks: expiryHooks})
+	assert.ErrorContains(t, err, "boom")
+	found, err := table.Has(ctx, entry)
+	assert.NilError(t, err)
+	assert.Assert(t, found)
+
+	// expired entries go through the regular delete path
+	expiryHooks.EXPECT().OnExpire(ctx, ormmocks.Eq(entry))
+	writeHooks.EXPECT().OnDelete(ctx, ormmocks.Eq(entry))
+	res, err := table.PruneExpired(ctx, ormtable.PruneExpiredOptions{BlockTime: now, Hooks: expiryHooks})
+	assert.NilError(t, err)
+	assert.Equal(t, uint64(1), res.Pruned)
+}
+
+func TestBadTTL(t *testing.T) {
+	messageType := (&testpb.ExampleTTL{}).ProtoReflect().Type()
+	desc := func(field string, indexId uint32) *ormv1.TableDescriptor {
+		return &ormv1.TableDescriptor{
+			Id:         1,
+			PrimaryKey: &ormv1.PrimaryKeyDescriptor{Fields: "id"},
+			Index:      []*ormv1.SecondaryIndexDescriptor{{Id: 1, Fields: "name"}},
+			Ttl:        &ormv1.TTLDescriptor{ExpirationField: field, IndexId: indexId},
+		}
+	}
+
+	_, err := ormtable.Build(ormtable.Options{MessageType: messageType, TableDescriptor: desc("foo", 2)})
+	assert.ErrorIs(t, err, ormerrors.FieldNotFound)
+
+	_, err = ormtable.Build(ormtable.Options{MessageType: messageType, TableDescriptor: desc("name", 2)})
+	assert.ErrorIs(t, err, ormerrors.InvalidTableDefinition)
+
+	_, err = ormtable.Build(ormtable.Options{MessageType: messageType, TableDescriptor: desc("expiration", 1)})
+	assert.ErrorIs(t, err, ormerrors.DuplicateIndexId)
+
+	table, err := ormtable.Build(ormtable.Options{MessageType: messageType, TableDescriptor: desc("expiration", 2)})
+	assert.NilError(t, err)
+	assert.Equal(t, table.GetIndexByID(2), table.ExpirationIndex())
+}
+
+type expiryHooks struct {
+	onExpire func(proto.Message) error
+}
+
+func (e *expiryHooks) OnExpire(_ context.Context, message proto.Message) error {
+	return e.onExpire(message)
+}
@@ -135,3 +135,40 @@ func (mr *MockWriteHooksMockRecorder) OnUpdate(ctx, existing, new interface{}) *
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnUpdate", reflect.TypeOf((*MockWriteHooks)(nil).OnUpdate), ctx, existing, new)
 }
+
+// MockExpiryHooks is a mock of ExpiryHooks interface.
+type MockExpiryHooks struct {
+	ctrl     *gomock.Controller
+	recorder *MockExpiryHooksMockRecorder
+}
+
+// MockExpiryHooksMockRecorder is the mock recorder for MockExpiryHooks.
+type MockExpiryHooksMockRecorder struct {
+	mock *MockExpiryHooks
+}
+
+// NewMockExpiryHooks creates a new mock instance.
+func NewMockExpiryHooks(ctrl *gomock.Controller) *MockExpiryHooks {
+	mock := &MockExpiryHooks{ctrl: ctrl}
+	mock.recorder = &MockExpiryHooksMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExpiryHooks) EXPECT() *MockExpiryHooksMockRecorder {
+	return m.recorder
+}
+
+// OnExpire mocks base method.
+func (m *MockExpiryHooks) OnExpire(arg0 context.Context, arg1 proto.Message) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OnExpire", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OnExpire indicates an expected call of OnExpire.
+func (mr *MockExpiryHooksMockRecorder) OnExpire(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnExpire", reflect.TypeOf((*MockExpiryHooks)(nil).OnExpire), arg0, arg1)
+}
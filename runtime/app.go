@@ -0,0 +1,218 @@
+package runtime
+
+import (
+	"encoding/json"
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	runtimev1alpha1 "github.com/cosmos/cosmos-sdk/api/cosmos/app/runtime/v1alpha1"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/server/api"
+	"github.com/cosmos/cosmos-sdk/server/config"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authmiddleware "github.com/cosmos/cosmos-sdk/x/auth/middleware"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+)
+
+var _ servertypes.Application = (*App)(nil)
+
+// App is a wrapper around BaseApp and ModuleManager that can be used in hybrid
+// app.go/app config scenarios or directly as a servertypes.Application instance.
+// To get an instance of *App, *AppBuilder must be requested as a dependency
+// in a container which declares the runtime module and the AppBuilder.Build()
+// method must be called.
+//
+// The *App is also provided to modules in the container before it has been
+// built so that they can reference the BaseApp, for instance to deliver
+// genesis transactions. Modules must not call any BaseApp methods until the
+// app has been built.
+type App struct {
+	*baseapp.BaseApp
+
+	// ModuleManager is the module manager of the app with all the modules of
+	// the app config in the order specified by the runtime module config.
+	ModuleManager *module.Manager
+
+	config            *runtimev1alpha1.Module
+	storeKeys         map[string]storetypes.StoreKey
+	interfaceRegistry codectypes.InterfaceRegistry
+	cdc               codec.Codec
+	amino             *codec.LegacyAmino
+	basicManager      module.BasicManager
+	baseAppOptions    []BaseAppOption
+	msgServiceRouter  *authmiddleware.MsgServiceRouter
+	legacyRouter      sdk.Router
+	invariantRegistry sdk.InvariantRegistry
+	configurator      module.Configurator
+}
+
+// RegisterModules registers the provided modules with the module manager and
+// the basic module manager. This is the primary hook for integrating with
+// modules which are not registered using the app config.
+func (a *App) RegisterModules(modules ...module.AppModule) error {
+	for _, appModule := range modules {
+		name := appModule.Name()
+		if _, ok := a.ModuleManager.Modules[name]; ok {
+			return fmt.Errorf("AppModule named %q already exists", name)
+		}
+
+		if _, ok := a.basicManager[name]; ok {
+			return fmt.Errorf("AppModuleBasic named %q already exists", name)
+		}
+
+		a.ModuleManager.Modules[name] = appModule
+		a.basicManager[name] = appModule
+		appModule.RegisterInterfaces(a.interfaceRegistry)
+		appModule.RegisterLegacyAminoCodec(a.amino)
+	}
+
+	return nil
+}
+
+// Load finishes all initialization operations and loads the app.
+func (a *App) Load(loadLatest bool) error {
+	a.configurator = module.NewConfigurator(a.cdc, a.msgServiceRouter, a.GRPCQueryRouter())
+	a.ModuleManager.RegisterServices(a.configurator)
+	a.ModuleManager.RegisterRoutes(a.legacyRouter, a.QueryRouter(), a.amino)
+
+	if a.invariantRegistry != nil {
+		a.ModuleManager.RegisterInvariants(a.invariantRegistry)
+	}
+
+	a.SetInitChainer(a.InitChainer)
+	a.SetBeginBlocker(a.BeginBlocker)
+	a.SetEndBlocker(a.EndBlocker)
+
+	if loadLatest {
+		if err := a.LoadLatestVersion(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// BeginBlocker application updates every begin block
+func (a *App) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	return a.ModuleManager.BeginBlock(ctx, req)
+}
+
+// EndBlocker application updates every end block
+func (a *App) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
+	return a.ModuleManager.EndBlock(ctx, req)
+}
+
+// InitChainer initializes the chain.
+func (a *App) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
+	var genesisState map[string]json.RawMessage
+	if err := json.Unmarshal(req.AppStateBytes, &genesisState); err != nil {
+		panic(err)
+	}
+
+	return a.ModuleManager.InitGenesis(ctx, a.cdc, genesisState)
+}
+
+// LoadHeight loads a particular height
+func (a *App) LoadHeight(height int64) error {
+	return a.LoadVersion(height)
+}
+
+// RegisterAPIRoutes registers all application module routes with the provided
+// API server.
+func (a *App) RegisterAPIRoutes(apiSvr *api.Server, _ config.APIConfig) {
+	clientCtx := apiSvr.ClientCtx
+	// Register new tx routes from grpc-gateway.
+	authtx.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+	// Register new tendermint queries routes from grpc-gateway.
+	tmservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+
+	// Register legacy and grpc-gateway routes for all modules.
+	a.basicManager.RegisterRESTRoutes(clientCtx, apiSvr.Router)
+	a.basicManager.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+}
+
+// RegisterTxService implements the Application.RegisterTxService method.
+func (a *App) RegisterTxService(clientCtx client.Context) {
+	authtx.RegisterTxService(a.GRPCQueryRouter(), clientCtx, a.Simulate, a.interfaceRegistry)
+}
+
+// RegisterTendermintService implements the Application.RegisterTendermintService method.
+func (a *App) RegisterTendermintService(clientCtx client.Context) {
+	tmservice.RegisterTendermintService(a.GRPCQueryRouter(), clientCtx, a.interfaceRegistry)
+}
+
+// Configurator returns the app's module configurator. It is only set once
+// the app has been loaded.
+func (a *App) Configurator() module.Configurator {
+	return a.configurator
+}
+
+// AppCodec returns the app's codec.
+func (a *App) AppCodec() codec.Codec {
+	return a.cdc
+}
+
+// LegacyAmino returns the app's legacy amino codec.
+func (a *App) LegacyAmino() *codec.LegacyAmino {
+	return a.amino
+}
+
+// InterfaceRegistry returns the app's interface registry.
+func (a *App) InterfaceRegistry() codectypes.InterfaceRegistry {
+	return a.interfaceRegistry
+}
+
+// MsgServiceRouter returns the app's Msg service router.
+func (a *App) MsgServiceRouter() *authmiddleware.MsgServiceRouter {
+	return a.msgServiceRouter
+}
+
+// LegacyRouter returns the app's legacy router.
+func (a *App) LegacyRouter() sdk.Router {
+	return a.legacyRouter
+}
+
+// BasicManager returns the basic manager of the app's modules.
+func (a *App) BasicManager() module.BasicManager {
+	return a.basicManager
+}
+
+// GetStoreKey returns the store key with the provided name or nil if there is
+// no such store key.
+func (a *App) GetStoreKey(name string) storetypes.StoreKey {
+	return a.storeKeys[name]
+}
+
+// GetKVStoreKey returns the KV store key with the provided name or nil if
+// there is no such KV store key.
+func (a *App) GetKVStoreKey(name string) *storetypes.KVStoreKey {
+	key, _ := a.storeKeys[name].(*storetypes.KVStoreKey)
+	return key
+}
+
+// DeliverTxFunc returns a function which delivers a transaction to the app.
+// Unlike the BaseApp.DeliverTx method value, it can be retrieved before the
+// app has been built.
+func (a *App) DeliverTxFunc() func(abci.RequestDeliverTx) abci.ResponseDeliverTx {
+	return func(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
+		return a.BaseApp.DeliverTx(req)
+	}
+}
+
+func (a *App) registerStoreKey(key storetypes.StoreKey) error {
+	name := key.Name()
+	if _, ok := a.storeKeys[name]; ok {
+		return fmt.Errorf("duplicate store key %s", name)
+	}
+
+	a.storeKeys[name] = key
+	return nil
+}
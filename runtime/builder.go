@@ -0,0 +1,58 @@
+package runtime
+
+import (
+	"encoding/json"
+	"io"
+	"sort"
+
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// AppBuilder is a type that is injected into a container by the runtime module
+// (as *AppBuilder) which can be used to create an app which is compatible with
+// the existing app.go initialization conventions.
+type AppBuilder struct {
+	app *App
+}
+
+// DefaultGenesis returns a default genesis from the registered AppModuleBasic's.
+func (a *AppBuilder) DefaultGenesis() map[string]json.RawMessage {
+	return a.app.basicManager.DefaultGenesis(a.app.cdc)
+}
+
+// Build builds an *App instance. The BaseApp options passed in are applied
+// before the BaseAppOption's provided by modules.
+func (a *AppBuilder) Build(logger log.Logger, db dbm.DB, traceStore io.Writer, baseAppOptions ...func(*baseapp.BaseApp)) *App {
+	bApp := baseapp.NewBaseApp(a.app.config.AppName, logger, db, baseAppOptions...)
+	bApp.SetCommitMultiStoreTracer(traceStore)
+	bApp.SetVersion(version.Version)
+	bApp.SetInterfaceRegistry(a.app.interfaceRegistry)
+
+	for _, option := range a.app.baseAppOptions {
+		option(bApp)
+	}
+
+	a.app.BaseApp = bApp
+
+	names := make([]string, 0, len(a.app.storeKeys))
+	for name := range a.app.storeKeys {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		switch key := a.app.storeKeys[name].(type) {
+		case *storetypes.MemoryStoreKey:
+			bApp.MountStore(key, storetypes.StoreTypeMemory)
+		default:
+			bApp.MountStores(key)
+		}
+	}
+
+	return a.app
+}
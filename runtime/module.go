@@ -0,0 +1,241 @@
+package runtime
+
+import (
+	"fmt"
+
+	runtimev1alpha1 "github.com/cosmos/cosmos-sdk/api/cosmos/app/runtime/v1alpha1"
+	appv1alpha1 "github.com/cosmos/cosmos-sdk/api/cosmos/app/v1alpha1"
+	"github.com/cosmos/cosmos-sdk/appconfig"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/container"
+	"github.com/cosmos/cosmos-sdk/std"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authmiddleware "github.com/cosmos/cosmos-sdk/x/auth/middleware"
+)
+
+// AppModuleWrapper is a type used for injecting a module.AppModule into a
+// container so that it can be used by the runtime module.
+type AppModuleWrapper struct {
+	module.AppModule
+}
+
+// WrapAppModule wraps a module.AppModule so that it can be injected into
+// a container for use by the runtime module.
+func WrapAppModule(appModule module.AppModule) AppModuleWrapper {
+	return AppModuleWrapper{AppModule: appModule}
+}
+
+// IsOnePerModuleType identifies this type as a container.OnePerModuleType.
+func (AppModuleWrapper) IsOnePerModuleType() {}
+
+// AppModuleBasicWrapper is a type used for injecting a module.AppModuleBasic
+// into a container so that it can be used by the runtime module.
+type AppModuleBasicWrapper struct {
+	module.AppModuleBasic
+}
+
+// WrapAppModuleBasic wraps a module.AppModuleBasic so that it can be injected into
+// a container for use by the runtime module.
+func WrapAppModuleBasic(basic module.AppModuleBasic) AppModuleBasicWrapper {
+	return AppModuleBasicWrapper{AppModuleBasic: basic}
+}
+
+// IsOnePerModuleType identifies this type as a container.OnePerModuleType.
+func (AppModuleBasicWrapper) IsOnePerModuleType() {}
+
+// BaseAppOption is an auto-group type which modules can provide to configure
+// the BaseApp once it has been created in AppBuilder.Build, for instance to
+// set its tx handler or param store. Options are applied in the order in which
+// the modules providing them appear in the app config.
+type BaseAppOption func(*baseapp.BaseApp)
+
+// IsAutoGroupType identifies this type as a container.AutoGroupType.
+func (BaseAppOption) IsAutoGroupType() {}
+
+func init() {
+	appconfig.Register(&runtimev1alpha1.Module{},
+		appconfig.Provide(
+			provideCodecs,
+			provideApp,
+			provideAppBuilder,
+			provideRouters,
+		),
+		appconfig.ProvideModuleScoped(
+			provideKVStoreKey,
+			provideTransientStoreKey,
+			provideMemoryStoreKey,
+		),
+	)
+}
+
+func provideCodecs(moduleBasics map[string]AppModuleBasicWrapper) (
+	codectypes.InterfaceRegistry,
+	codec.Codec,
+	*codec.LegacyAmino,
+	codec.ProtoCodecMarshaler,
+) {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	amino := codec.NewLegacyAmino()
+
+	std.RegisterInterfaces(interfaceRegistry)
+	std.RegisterLegacyAminoCodec(amino)
+
+	for _, basic := range moduleBasics {
+		basic.RegisterInterfaces(interfaceRegistry)
+		basic.RegisterLegacyAminoCodec(amino)
+	}
+
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+	return interfaceRegistry, cdc, amino, cdc
+}
+
+func provideRouters(interfaceRegistry codectypes.InterfaceRegistry) (*authmiddleware.MsgServiceRouter, sdk.Router) {
+	return authmiddleware.NewMsgServiceRouter(interfaceRegistry), authmiddleware.NewLegacyRouter()
+}
+
+type appInputs struct {
+	container.In
+
+	Config            *runtimev1alpha1.Module
+	InterfaceRegistry codectypes.InterfaceRegistry
+	Codec             codec.Codec
+	Amino             *codec.LegacyAmino
+	MsgServiceRouter  *authmiddleware.MsgServiceRouter
+	LegacyRouter      sdk.Router
+	ModuleBasics      map[string]AppModuleBasicWrapper
+}
+
+func provideApp(inputs appInputs) *App {
+	basicManager := module.BasicManager{}
+	for name, basic := range inputs.ModuleBasics {
+		basicManager[name] = basic.AppModuleBasic
+	}
+
+	return &App{
+		config:            inputs.Config,
+		interfaceRegistry: inputs.InterfaceRegistry,
+		cdc:               inputs.Codec,
+		amino:             inputs.Amino,
+		msgServiceRouter:  inputs.MsgServiceRouter,
+		legacyRouter:      inputs.LegacyRouter,
+		basicManager:      basicManager,
+		storeKeys:         map[string]storetypes.StoreKey{},
+	}
+}
+
+type appBuilderInputs struct {
+	container.In
+
+	App               *App
+	AppConfig         *appv1alpha1.Config
+	Modules           map[string]AppModuleWrapper
+	BaseAppOptions    []BaseAppOption
+	InvariantRegistry sdk.InvariantRegistry `optional:"true"`
+}
+
+func provideAppBuilder(inputs appBuilderInputs) (*AppBuilder, error) {
+	app := inputs.App
+
+	// modules are added to the module manager in the order in which they
+	// appear in the app config which is used as the default order
+	var modules []module.AppModule
+	for _, moduleConfig := range inputs.AppConfig.Modules {
+		wrapper, ok := inputs.Modules[moduleConfig.Name]
+		if !ok {
+			continue
+		}
+
+		if wrapper.Name() != moduleConfig.Name {
+			return nil, fmt.Errorf("module %s is named %s in the app config, module names must match",
+				wrapper.Name(), moduleConfig.Name)
+		}
+
+		modules = append(modules, wrapper.AppModule)
+	}
+
+	mm := module.NewManager(modules...)
+	moduleNames := mm.OrderInitGenesis
+
+	beginBlockers, err := orderModules(moduleNames, app.config.BeginBlockers)
+	if err != nil {
+		return nil, fmt.Errorf("invalid begin_blockers: %w", err)
+	}
+	mm.SetOrderBeginBlockers(beginBlockers...)
+
+	endBlockers, err := orderModules(moduleNames, app.config.EndBlockers)
+	if err != nil {
+		return nil, fmt.Errorf("invalid end_blockers: %w", err)
+	}
+	mm.SetOrderEndBlockers(endBlockers...)
+
+	initGenesis, err := orderModules(moduleNames, app.config.InitGenesis)
+	if err != nil {
+		return nil, fmt.Errorf("invalid init_genesis: %w", err)
+	}
+	mm.SetOrderInitGenesis(initGenesis...)
+	mm.SetOrderExportGenesis(initGenesis...)
+
+	app.ModuleManager = mm
+	app.baseAppOptions = inputs.BaseAppOptions
+	app.invariantRegistry = inputs.InvariantRegistry
+
+	return &AppBuilder{app: app}, nil
+}
+
+func provideKVStoreKey(key container.ModuleKey, app *App) (*storetypes.KVStoreKey, error) {
+	name := key.Name()
+	for _, override := range app.config.OverrideStoreKeys {
+		if override.ModuleName == name {
+			name = override.KvStoreKey
+		}
+	}
+
+	storeKey := storetypes.NewKVStoreKey(name)
+	return storeKey, app.registerStoreKey(storeKey)
+}
+
+func provideTransientStoreKey(key container.ModuleKey, app *App) (*storetypes.TransientStoreKey, error) {
+	storeKey := storetypes.NewTransientStoreKey(fmt.Sprintf("transient_%s", key.Name()))
+	return storeKey, app.registerStoreKey(storeKey)
+}
+
+func provideMemoryStoreKey(key container.ModuleKey, app *App) (*storetypes.MemoryStoreKey, error) {
+	storeKey := storetypes.NewMemoryStoreKey(fmt.Sprintf("mem_%s", key.Name()))
+	return storeKey, app.registerStoreKey(storeKey)
+}
+
+// orderModules returns the modules listed in order followed by the remaining
+// modules in their default order.
+func orderModules(moduleNames []string, order []string) ([]string, error) {
+	known := map[string]bool{}
+	for _, name := range moduleNames {
+		known[name] = true
+	}
+
+	res := make([]string, 0, len(moduleNames))
+	listed := map[string]bool{}
+	for _, name := range order {
+		if !known[name] {
+			return nil, fmt.Errorf("unknown module %s", name)
+		}
+
+		if listed[name] {
+			return nil, fmt.Errorf("module %s is listed more than once", name)
+		}
+
+		listed[name] = true
+		res = append(res, name)
+	}
+
+	for _, name := range moduleNames {
+		if !listed[name] {
+			res = append(res, name)
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,25 @@
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderModules(t *testing.T) {
+	modules := []string{"a", "b", "c", "d"}
+
+	order, err := orderModules(modules, nil)
+	require.NoError(t, err)
+	require.Equal(t, modules, order)
+
+	order, err = orderModules(modules, []string{"c", "a"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"c", "a", "b", "d"}, order)
+
+	_, err = orderModules(modules, []string{"e"})
+	require.EqualError(t, err, "unknown module e")
+
+	_, err = orderModules(modules, []string{"a", "b", "a"})
+	require.EqualError(t, err, "module a is listed more than once")
+}
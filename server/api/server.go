@@ -16,6 +16,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	"github.com/cosmos/cosmos-sdk/codec/unknownproto"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
@@ -33,6 +34,10 @@ type Server struct {
 	logger   log.Logger
 	metrics  *telemetry.Metrics
 	listener net.Listener
+
+	// queryUnknownFields specifies which unknown fields of the requests sent
+	// to the gRPC-gateway are rejected. It is set when the server is started.
+	queryUnknownFields unknownproto.Strictness
 }
 
 // CustomGRPCHeaderMatcher for mapping request headers to
@@ -59,23 +64,30 @@ func New(clientCtx client.Context, logger log.Logger) *Server {
 		AnyResolver:  clientCtx.InterfaceRegistry,
 	}
 
-	return &Server{
+	s := &Server{
 		Router:    mux.NewRouter(),
 		ClientCtx: clientCtx,
 		logger:    logger,
-		GRPCGatewayRouter: runtime.NewServeMux(
-			// Custom marshaler option is required for gogo proto
-			runtime.WithMarshalerOption(runtime.MIMEWildcard, marshalerOption),
-
-			// This is necessary to get error details properly
-			// marshalled in unary requests.
-			runtime.WithProtoErrorHandler(runtime.DefaultHTTPProtoErrorHandler),
-
-			// Custom header matcher for mapping request headers to
-			// GRPC metadata
-			runtime.WithIncomingHeaderMatcher(CustomGRPCHeaderMatcher),
-		),
 	}
+
+	s.GRPCGatewayRouter = runtime.NewServeMux(
+		// Custom marshaler option is required for gogo proto
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, gatewayMarshaler{JSONPb: marshalerOption, server: s}),
+
+		// Custom query parameter parser for rejecting unknown query parameters.
+		// Note that the query parameter parser of the gRPC-Gateway is global.
+		runtime.SetQueryParameterParser(queryParameterParser{server: s}),
+
+		// This is necessary to get error details properly
+		// marshalled in unary requests.
+		runtime.WithProtoErrorHandler(runtime.DefaultHTTPProtoErrorHandler),
+
+		// Custom header matcher for mapping request headers to
+		// GRPC metadata
+		runtime.WithIncomingHeaderMatcher(CustomGRPCHeaderMatcher),
+	)
+
+	return s
 }
 
 // Start starts the API server. Internally, the API server leverages Tendermint's
@@ -83,6 +95,12 @@ func New(clientCtx client.Context, logger log.Logger) *Server {
 // and are delegated to the Tendermint JSON RPC server. The process is
 // non-blocking, so an external signal handler must be used.
 func (s *Server) Start(cfg config.Config) error {
+	queryUnknownFields, err := unknownproto.ParseStrictness(cfg.QueryUnknownFields)
+	if err != nil {
+		return err
+	}
+	s.queryUnknownFields = queryUnknownFields
+
 	if cfg.Telemetry.Enabled {
 		m, err := telemetry.New(cfg.Telemetry)
 		if err != nil {
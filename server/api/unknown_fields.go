@@ -0,0 +1,174 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/gogo/gateway"
+	"github.com/gogo/protobuf/jsonpb"
+	gogoproto "github.com/gogo/protobuf/proto"
+	"github.com/golang/protobuf/proto" // nolint: staticcheck
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/utilities"
+
+	"github.com/cosmos/cosmos-sdk/codec/unknownproto"
+)
+
+// JSON request bodies and query parameters don't carry field numbers, so the
+// critical and non-critical unknown fields can't be told apart: both are
+// rejected unless the strictness of the server is unknownproto.StrictnessNone.
+func (s *Server) rejectsUnknownFields() bool {
+	return s.queryUnknownFields != unknownproto.StrictnessNone
+}
+
+// gatewayMarshaler is the gRPC-gateway marshaler of the API server. It decodes
+// request bodies like gateway.JSONPb, except that it rejects the unknown
+// fields if the server is configured to do so.
+type gatewayMarshaler struct {
+	*gateway.JSONPb
+	server *Server
+}
+
+var _ runtime.Marshaler = gatewayMarshaler{}
+
+// Unmarshal implements runtime.Marshaler.
+func (m gatewayMarshaler) Unmarshal(data []byte, v interface{}) error {
+	return m.NewDecoder(bytes.NewReader(data)).Decode(v)
+}
+
+// NewDecoder implements runtime.Marshaler.
+func (m gatewayMarshaler) NewDecoder(r io.Reader) runtime.Decoder {
+	if !m.server.rejectsUnknownFields() {
+		return m.JSONPb.NewDecoder(r)
+	}
+
+	decoder := json.NewDecoder(r)
+	return runtime.DecoderFunc(func(v interface{}) error {
+		msg, ok := v.(gogoproto.Message)
+		if !ok {
+			// only messages can have unknown fields
+			var raw json.RawMessage
+			if err := decoder.Decode(&raw); err != nil {
+				return err
+			}
+			return m.JSONPb.Unmarshal(raw, v)
+		}
+
+		unmarshaler := &jsonpb.Unmarshaler{AnyResolver: m.AnyResolver}
+		return unmarshaler.UnmarshalNext(decoder, msg)
+	})
+}
+
+var queryKeyMapRegexp = regexp.MustCompile(`^(.*)\[(.*)\]$`)
+
+// queryParameterParser is the gRPC-gateway query parameter parser of the API
+// server. It populates request messages like the default parser of the
+// gRPC-gateway, except that it rejects the query parameters which don't match
+// any field if the server is configured to do so. The default parser silently
+// ignores them.
+type queryParameterParser struct {
+	server *Server
+}
+
+var _ runtime.QueryParameterParser = queryParameterParser{}
+
+// Parse implements runtime.QueryParameterParser.
+func (p queryParameterParser) Parse(msg proto.Message, values url.Values, filter *utilities.DoubleArray) error {
+	rejectUnknownFields := p.server.rejectsUnknownFields()
+
+	for key, keyValues := range values {
+		if queryKeyMapRegexp.MatchString(key) {
+			// map fields can't be populated through the exported functions of
+			// the gRPC-gateway and no query request has such fields
+			if rejectUnknownFields {
+				return fmt.Errorf("unsupported query parameter %q: map fields are not supported", key)
+			}
+			continue
+		}
+
+		fieldPath := strings.Split(key, ".")
+		if filter.HasCommonPrefix(fieldPath) {
+			continue
+		}
+
+		repeated, err := checkQueryFieldPath(reflect.TypeOf(msg), fieldPath)
+		if err != nil {
+			if rejectUnknownFields {
+				return fmt.Errorf("unknown query parameter %q: %w", key, err)
+			}
+			continue
+		}
+
+		if !repeated && len(keyValues) > 1 {
+			keyValues = keyValues[:1]
+		}
+
+		// each value is populated in a new message which is then merged into
+		// msg so that the values of repeated fields are appended
+		for _, value := range keyValues {
+			fieldMsg := reflect.New(reflect.TypeOf(msg).Elem()).Interface().(proto.Message)
+			if err := runtime.PopulateFieldFromPath(fieldMsg, key, value); err != nil {
+				return err
+			}
+			gogoproto.Merge(msg, fieldMsg)
+		}
+	}
+
+	return nil
+}
+
+// checkQueryFieldPath checks that fieldPath is the path of a field of messages
+// of type typ, which is a pointer to a message struct, and returns whether the
+// field is repeated.
+func checkQueryFieldPath(typ reflect.Type, fieldPath []string) (repeated bool, err error) {
+	for i, fieldName := range fieldPath {
+		if typ.Kind() != reflect.Ptr || typ.Elem().Kind() != reflect.Struct {
+			return false, fmt.Errorf("%s is not a message", strings.Join(fieldPath[:i], "."))
+		}
+
+		fieldType, ok := queryFieldType(typ.Elem(), fieldName)
+		if !ok {
+			return false, fmt.Errorf("no field %s in %s", fieldName, typ)
+		}
+
+		typ = fieldType
+		repeated = typ.Kind() == reflect.Slice && typ.Elem().Kind() != reflect.Uint8
+		if repeated && i != len(fieldPath)-1 {
+			return false, fmt.Errorf("%s is a repeated field", strings.Join(fieldPath[:i+1], "."))
+		}
+
+		if typ.Kind() == reflect.Struct {
+			// non-nullable message fields
+			typ = reflect.PtrTo(typ)
+		}
+	}
+
+	return repeated, nil
+}
+
+// queryFieldType returns the type of the field of the message struct type typ
+// whose protobuf or JSON name is name, including oneof fields, like the
+// gRPC-gateway does when populating query parameters.
+func queryFieldType(typ reflect.Type, name string) (reflect.Type, bool) {
+	props := gogoproto.GetProperties(typ)
+
+	for _, oneof := range props.OneofTypes {
+		if name == oneof.Prop.OrigName || name == oneof.Prop.JSONName {
+			return oneof.Type.Elem().Field(0).Type, true
+		}
+	}
+
+	for i, prop := range props.Prop {
+		if prop.OrigName == name || prop.JSONName == name {
+			return typ.Field(i).Type, true
+		}
+	}
+
+	return nil, false
+}
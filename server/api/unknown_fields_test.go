@@ -0,0 +1,78 @@
+package api
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/gogo/gateway"
+	"github.com/grpc-ecosystem/grpc-gateway/utilities"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec/unknownproto"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestQueryParameterParser(t *testing.T) {
+	server := &Server{}
+	parser := queryParameterParser{server: server}
+	filter := utilities.NewDoubleArray([][]string{{"address"}})
+
+	values := url.Values{
+		"address":          {"ignored"},
+		"pagination.limit": {"10"},
+		"pagination.key":   {"Zm9v"},
+	}
+	var balancesReq banktypes.QueryAllBalancesRequest
+	require.NoError(t, parser.Parse(&balancesReq, values, filter))
+	require.Equal(t, banktypes.QueryAllBalancesRequest{
+		Pagination: &query.PageRequest{Limit: 10, Key: []byte("foo")},
+	}, balancesReq)
+
+	// the values of repeated fields are appended
+	var eventsReq tx.GetTxsEventRequest
+	require.NoError(t, parser.Parse(&eventsReq, url.Values{
+		"events":   {"message.action='send'", "message.sender='foo'"},
+		"order_by": {"2"},
+	}, utilities.NewDoubleArray(nil)))
+	require.Equal(t, []string{"message.action='send'", "message.sender='foo'"}, eventsReq.Events)
+	require.Equal(t, tx.OrderBy_ORDER_BY_DESC, eventsReq.OrderBy)
+
+	for _, key := range []string{"foo", "pagination.foo", "pagination.limit.foo", "events[foo]"} {
+		values := url.Values{key: {"1"}}
+		require.Error(t, parser.Parse(&balancesReq, values, filter), key)
+
+		server.queryUnknownFields = unknownproto.StrictnessNone
+		require.NoError(t, parser.Parse(&balancesReq, values, filter), key)
+		server.queryUnknownFields = unknownproto.StrictnessAll
+	}
+}
+
+func TestGatewayMarshaler(t *testing.T) {
+	server := &Server{}
+	marshaler := gatewayMarshaler{
+		JSONPb: &gateway.JSONPb{AnyResolver: testdata.NewTestInterfaceRegistry()},
+		server: server,
+	}
+
+	var msg testdata.EchoRequest
+	require.NoError(t, marshaler.Unmarshal([]byte(`{"message":"hello"}`), &msg))
+	require.Equal(t, "hello", msg.Message)
+
+	unknown := []byte(`{"message":"hello","foo":1}`)
+	require.Error(t, marshaler.Unmarshal(unknown, &msg))
+
+	server.queryUnknownFields = unknownproto.StrictnessCritical
+	require.Error(t, marshaler.Unmarshal(unknown, &msg))
+
+	server.queryUnknownFields = unknownproto.StrictnessNone
+	require.NoError(t, marshaler.Unmarshal(unknown, &msg))
+
+	// non-message values are decoded as by gateway.JSONPb
+	server.queryUnknownFields = unknownproto.StrictnessAll
+	var s string
+	require.NoError(t, marshaler.Unmarshal([]byte(`"hello"`), &s))
+	require.Equal(t, "hello", s)
+}
@@ -81,6 +81,11 @@ iavl-cache-size = {{ .BaseConfig.IAVLCacheSize }}
 # Second fallback (if the types.DBBackend also isn't set), is the db-backend value set in Tendermint's config.toml.
 app-db-backend = "{{ .BaseConfig.AppDBBackend }}"
 
+# QueryUnknownFields defines which unknown fields of gRPC query requests, including
+# the ones sent through the REST gateway, are rejected: "all", "critical" (only the
+# fields without bit 11 set in their field number) or "none".
+query-unknown-fields = "{{ .BaseConfig.QueryUnknownFields }}"
+
 ###############################################################################
 ###                         Telemetry Configuration                         ###
 ###############################################################################
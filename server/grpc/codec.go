@@ -0,0 +1,34 @@
+package grpc
+
+import (
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/grpc/encoding"
+
+	"github.com/cosmos/cosmos-sdk/codec/unknownproto"
+)
+
+// unknownFieldsCodec is a gRPC server codec which rejects the unknown fields of
+// the requests it decodes according to its strictness before decoding them
+// with the wrapped codec. The gRPC server reports decoding errors to clients
+// as internal errors which include the error of the codec.
+type unknownFieldsCodec struct {
+	encoding.Codec
+	strictness unknownproto.Strictness
+	resolver   jsonpb.AnyResolver
+}
+
+func newUnknownFieldsCodec(cdc encoding.Codec, strictness unknownproto.Strictness, resolver jsonpb.AnyResolver) encoding.Codec {
+	return unknownFieldsCodec{Codec: cdc, strictness: strictness, resolver: resolver}
+}
+
+// Unmarshal implements encoding.Codec.
+func (c unknownFieldsCodec) Unmarshal(data []byte, v interface{}) error {
+	if msg, ok := v.(proto.Message); ok {
+		if err := c.strictness.RejectUnknownFields(data, msg, c.resolver); err != nil {
+			return err
+		}
+	}
+
+	return c.Codec.Unmarshal(data, v)
+}
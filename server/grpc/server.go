@@ -2,29 +2,42 @@ package grpc
 
 import (
 	"fmt"
-	"github.com/cosmos/cosmos-sdk/codec"
 	"net"
 	"time"
 
 	"google.golang.org/grpc"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/unknownproto"
+	"github.com/cosmos/cosmos-sdk/server/config"
 	"github.com/cosmos/cosmos-sdk/server/grpc/gogoreflection"
 	reflection "github.com/cosmos/cosmos-sdk/server/grpc/reflection/v2alpha1"
 	"github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// StartGRPCServer starts a gRPC server on the given address.
-func StartGRPCServer(clientCtx client.Context, app types.Application, address string) (*grpc.Server, error) {
+// StartGRPCServer starts a gRPC server on the address of the gRPC config. The
+// server rejects the unknown fields of query requests according to the
+// QueryUnknownFields base config.
+func StartGRPCServer(clientCtx client.Context, app types.Application, cfg config.Config) (*grpc.Server, error) {
+	strictness, err := unknownproto.ParseStrictness(cfg.QueryUnknownFields)
+	if err != nil {
+		return nil, err
+	}
+
 	grpcSrv := grpc.NewServer(
-		grpc.ForceServerCodec(codec.NewProtoCodec(clientCtx.InterfaceRegistry).GRPCCodec()),
+		grpc.ForceServerCodec(newUnknownFieldsCodec(
+			codec.NewProtoCodec(clientCtx.InterfaceRegistry).GRPCCodec(),
+			strictness,
+			clientCtx.InterfaceRegistry,
+		)),
 	)
 
 	app.RegisterGRPCServer(grpcSrv)
 	// reflection allows consumers to build dynamic clients that can write
 	// to any cosmos-sdk application without relying on application packages at compile time
-	err := reflection.Register(grpcSrv, reflection.Config{
+	err = reflection.Register(grpcSrv, reflection.Config{
 		SigningModes: func() map[string]int32 {
 			modes := make(map[string]int32, len(clientCtx.TxConfig.SignModeHandler().Modes()))
 			for _, m := range clientCtx.TxConfig.SignModeHandler().Modes() {
@@ -42,7 +55,7 @@ func StartGRPCServer(clientCtx client.Context, app types.Application, address st
 	// Reflection allows external clients to see what services and methods
 	// the gRPC server exposes.
 	gogoreflection.Register(grpcSrv)
-	listener, err := net.Listen("tcp", address)
+	listener, err := net.Listen("tcp", cfg.GRPC.Address)
 	if err != nil {
 		return nil, err
 	}
@@ -81,6 +81,20 @@ func (s *IntegrationTestSuite) TestGRPCServer_TestService() {
 	s.Require().Equal("hello", testRes.Message)
 }
 
+func (s *IntegrationTestSuite) TestGRPCServer_UnknownFields() {
+	// QueryBalanceRequest has the same first field as EchoRequest and an
+	// additional second field which is unknown to the Echo method
+	req := &banktypes.QueryBalanceRequest{Address: "hello", Denom: "stake"}
+	err := s.conn.Invoke(context.Background(), "/testdata.Query/Echo", req, &testdata.EchoResponse{})
+	s.Require().Error(err)
+	s.Require().Contains(err.Error(), "errUnknownField")
+
+	var res testdata.EchoResponse
+	err = s.conn.Invoke(context.Background(), "/testdata.Query/Echo", &banktypes.QueryBalanceRequest{Address: "hello"}, &res)
+	s.Require().NoError(err)
+	s.Require().Equal("hello", res.Message)
+}
+
 func (s *IntegrationTestSuite) TestGRPCServer_BankBalance() {
 	val0 := s.network.Validators[0]
 
@@ -0,0 +1,91 @@
+package simapp
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/appconfig"
+	"github.com/cosmos/cosmos-sdk/container"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil/mock"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+//go:embed app.yaml
+var appConfigYAML []byte
+
+func TestAppConfigWiring(t *testing.T) {
+	var (
+		appBuilder    *runtime.AppBuilder
+		accountKeeper authkeeper.AccountKeeper
+		bankKeeper    bankkeeper.Keeper
+	)
+	err := container.Run(func(b *runtime.AppBuilder, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper) {
+		appBuilder, accountKeeper, bankKeeper = b, ak, bk
+	}, appconfig.LoadYAML(appConfigYAML))
+	require.NoError(t, err)
+
+	app := appBuilder.Build(log.NewNopLogger(), dbm.NewMemDB(), nil)
+	require.NoError(t, app.Load(true))
+
+	// the module manager uses the orders from the runtime config
+	mm := app.ModuleManager
+	require.Equal(t, []string{
+		"upgrade", "capability", "mint", "distribution", "slashing", "evidence", "staking",
+		"auth", "bank", "gov", "crisis", "genutil", "authz", "feegrant", "nft", "group", "params", "vesting",
+	}, mm.OrderBeginBlockers)
+	require.Equal(t, "capability", mm.OrderInitGenesis[0])
+	require.Equal(t, mm.OrderInitGenesis, mm.OrderExportGenesis)
+
+	// store keys are named after their module unless overridden
+	require.NotNil(t, app.GetKVStoreKey("acc"))
+	require.Nil(t, app.GetKVStoreKey("auth"))
+	require.NotNil(t, app.GetKVStoreKey("bank"))
+	require.NotNil(t, app.GetStoreKey("transient_params"))
+	require.NotNil(t, app.GetStoreKey("mem_capability"))
+
+	// all module accounts configured in the auth module are blocked by default
+	for _, perms := range accountKeeper.GetModulePermissions() {
+		require.True(t, bankKeeper.BlockedAddr(perms.GetAddress()))
+	}
+
+	privVal := mock.NewPV()
+	pubKey, err := privVal.GetPubKey(context.TODO())
+	require.NoError(t, err)
+	valSet := tmtypes.NewValidatorSet([]*tmtypes.Validator{tmtypes.NewValidator(pubKey, 1)})
+
+	senderPrivKey := secp256k1.GenPrivKey()
+	acc := authtypes.NewBaseAccount(senderPrivKey.PubKey().Address().Bytes(), senderPrivKey.PubKey(), 0, 0)
+	balance := banktypes.Balance{
+		Address: acc.GetAddress().String(),
+		Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100000000000000))),
+	}
+
+	genesisState := genesisStateWithValSet(t, app.AppCodec(), appBuilder.DefaultGenesis(), valSet, []authtypes.GenesisAccount{acc}, balance)
+	stateBytes, err := json.Marshal(genesisState)
+	require.NoError(t, err)
+
+	app.InitChain(abci.RequestInitChain{
+		Validators:      []abci.ValidatorUpdate{},
+		ConsensusParams: DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	app.Commit()
+
+	ctx := app.NewContext(true, tmproto.Header{})
+	require.Equal(t, balance.Coins, bankKeeper.GetAllBalances(ctx, acc.GetAddress()))
+}
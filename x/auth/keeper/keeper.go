@@ -184,6 +184,11 @@ func (ak AccountKeeper) GetModuleAddressAndPermissions(moduleName string) (addr
 	return permAddr.GetAddress(), permAddr.GetPermissions()
 }
 
+// GetModulePermissions fetches per-module account permissions.
+func (ak AccountKeeper) GetModulePermissions() map[string]types.PermissionsForAddress {
+	return ak.permAddrs
+}
+
 // GetModuleAccountAndPermissions gets the module account from the auth account store and its
 // registered permissions
 func (ak AccountKeeper) GetModuleAccountAndPermissions(ctx sdk.Context, moduleName string) (types.ModuleAccountI, []string) {
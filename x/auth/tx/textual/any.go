@@ -0,0 +1,101 @@
+package textual
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+const anyName protoreflect.FullName = "google.protobuf.Any"
+
+// anyRenderer renders google.protobuf.Any values as their type URL followed by
+// the screens of the packed message at indent 1, without the header screen
+// of the message which would be redundant with the type URL.
+type anyRenderer struct {
+	t  Textual
+	md protoreflect.MessageDescriptor
+}
+
+func (r anyRenderer) Format(ctx context.Context, v protoreflect.Value) ([]Screen, error) {
+	anyMsg := v.Message()
+	typeURL := anyMsg.Get(r.md.Fields().ByName("type_url")).String()
+	value := anyMsg.Get(r.md.Fields().ByName("value")).Bytes()
+
+	md, err := r.t.registry.FindMessageByURL(typeURL)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := dynamicpb.NewMessage(md)
+	if err := proto.Unmarshal(value, msg); err != nil {
+		return nil, err
+	}
+
+	vr, err := r.t.GetMessageValueRenderer(md)
+	if err != nil {
+		return nil, err
+	}
+
+	screens, err := vr.Format(ctx, protoreflect.ValueOfMessage(msg))
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := vr.(messageRenderer); ok {
+		screens = screens[1:]
+	} else {
+		for i := range screens {
+			screens[i].Indent++
+		}
+	}
+
+	return append([]Screen{{Text: typeURL}}, screens...), nil
+}
+
+func (r anyRenderer) Parse(ctx context.Context, screens []Screen) (protoreflect.Value, error) {
+	if len(screens) == 0 || screens[0].Indent != 0 {
+		return protoreflect.Value{}, fmt.Errorf("expected type URL screen")
+	}
+	typeURL := screens[0].Text
+
+	md, err := r.t.registry.FindMessageByURL(typeURL)
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+
+	vr, err := r.t.GetMessageValueRenderer(md)
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+
+	var msgScreens []Screen
+	if mr, ok := vr.(messageRenderer); ok {
+		msgScreens = append([]Screen{{Text: mr.header()}}, screens[1:]...)
+	} else {
+		msgScreens, err = unindent(screens[1:])
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+	}
+
+	msg, err := vr.Parse(ctx, msgScreens)
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+
+	value, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg.Message().Interface())
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+
+	anyMsg := dynamicpb.NewMessage(r.md)
+	anyMsg.Set(r.md.Fields().ByName("type_url"), protoreflect.ValueOfString(typeURL))
+	if len(value) > 0 {
+		anyMsg.Set(r.md.Fields().ByName("value"), protoreflect.ValueOfBytes(value))
+	}
+
+	return protoreflect.ValueOfMessage(anyMsg), nil
+}
@@ -0,0 +1,229 @@
+package textual
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const coinName protoreflect.FullName = "cosmos.base.v1beta1.Coin"
+
+// isCoins returns whether the field is a list of coins, which is rendered on
+// a single screen.
+func isCoins(fd protoreflect.FieldDescriptor) bool {
+	return fd.IsList() && fd.Message() != nil && fd.Message().FullName() == coinName
+}
+
+// coinRenderer renders coins as "<amount> <denom>" in the display denom of
+// their bank metadata, e.g. "1.5 atom" for 1500000uatom if the display denom
+// atom has an exponent of 6, or in their base denom if they have no metadata.
+type coinRenderer struct {
+	t  Textual
+	md protoreflect.MessageDescriptor
+}
+
+func (r coinRenderer) Format(ctx context.Context, v protoreflect.Value) ([]Screen, error) {
+	text, err := r.format(ctx, v.Message())
+	if err != nil {
+		return nil, err
+	}
+	return []Screen{{Text: text}}, nil
+}
+
+func (r coinRenderer) Parse(ctx context.Context, screens []Screen) (protoreflect.Value, error) {
+	text, err := singleScreen(screens)
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+
+	coin, err := r.parse(ctx, text)
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+	return protoreflect.ValueOfMessage(coin), nil
+}
+
+func (r coinRenderer) format(ctx context.Context, coin protoreflect.Message) (string, error) {
+	fields := r.md.Fields()
+	denom := coin.Get(fields.ByName("denom")).String()
+	amount := coin.Get(fields.ByName("amount")).String()
+
+	if amt, ok := new(big.Int).SetString(amount, 10); !ok || amt.Sign() < 0 {
+		return "", fmt.Errorf("invalid coin amount %q", amount)
+	}
+
+	metadata, err := r.t.coinMetadataQuerier(ctx, denom)
+	if err != nil {
+		return "", err
+	}
+	if metadata == nil {
+		return amount + " " + denom, nil
+	}
+	if metadata.Base != denom {
+		// the display denom of another coin would be ambiguous with denom
+		return "", fmt.Errorf("denom %s is the display denom of %s", denom, metadata.Base)
+	}
+
+	exponent, err := displayExponent(metadata)
+	if err != nil {
+		return "", err
+	}
+
+	return shiftDecimal(amount, exponent) + " " + metadata.Display, nil
+}
+
+func (r coinRenderer) parse(ctx context.Context, text string) (protoreflect.Message, error) {
+	parts := strings.Split(text, " ")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid coin %q", text)
+	}
+	amount, denom := parts[0], parts[1]
+
+	metadata, err := r.t.coinMetadataQuerier(ctx, denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if metadata != nil {
+		exponent, err := displayExponent(metadata)
+		if err != nil {
+			return nil, err
+		}
+
+		switch denom {
+		case metadata.Display:
+			amount, err = unshiftDecimal(amount, exponent)
+			if err != nil {
+				return nil, err
+			}
+			denom = metadata.Base
+		case metadata.Base:
+			if metadata.Display != metadata.Base {
+				return nil, fmt.Errorf("coin %q is not in its display denom %s", text, metadata.Display)
+			}
+		}
+	}
+
+	coin := dynamicpb.NewMessage(r.md)
+	coin.Set(r.md.Fields().ByName("denom"), protoreflect.ValueOfString(denom))
+	coin.Set(r.md.Fields().ByName("amount"), protoreflect.ValueOfString(amount))
+
+	// rendering must be injective, so only the canonical text is accepted
+	formatted, err := r.format(ctx, coin)
+	if err != nil {
+		return nil, err
+	}
+	if formatted != text {
+		return nil, fmt.Errorf("non-canonical coin %q, expected %q", text, formatted)
+	}
+
+	return coin, nil
+}
+
+// coinsRenderer renders lists of coins as a single screen of comma-separated
+// coins.
+type coinsRenderer struct {
+	t  Textual
+	md protoreflect.MessageDescriptor
+}
+
+func (r coinsRenderer) Format(ctx context.Context, v protoreflect.Value) ([]Screen, error) {
+	list := v.List()
+	cr := coinRenderer{t: r.t, md: r.md}
+
+	coins := make([]string, list.Len())
+	for i := range coins {
+		var err error
+		coins[i], err = cr.format(ctx, list.Get(i).Message())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return []Screen{{Text: strings.Join(coins, ", ")}}, nil
+}
+
+func (r coinsRenderer) parse(ctx context.Context, screens []Screen, list protoreflect.List) (protoreflect.Value, error) {
+	text, err := singleScreen(screens)
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+
+	cr := coinRenderer{t: r.t, md: r.md}
+	for _, s := range strings.Split(text, ", ") {
+		coin, err := cr.parse(ctx, s)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		list.Append(protoreflect.ValueOfMessage(coin))
+	}
+
+	return protoreflect.ValueOfList(list), nil
+}
+
+// displayExponent returns the exponent of the display denom of the metadata
+// relative to its base denom.
+func displayExponent(metadata *banktypes.Metadata) (int, error) {
+	var base, display *banktypes.DenomUnit
+	for _, unit := range metadata.DenomUnits {
+		switch unit.Denom {
+		case metadata.Base:
+			base = unit
+		case metadata.Display:
+			display = unit
+		}
+	}
+
+	if metadata.Display == metadata.Base {
+		return 0, nil
+	}
+	if base == nil || display == nil || display.Exponent < base.Exponent {
+		return 0, fmt.Errorf("invalid metadata of denom %s", metadata.Base)
+	}
+
+	return int(display.Exponent - base.Exponent), nil
+}
+
+// shiftDecimal divides the non-negative integer amount by 10^exponent and
+// returns the result as a decimal without trailing zeros.
+func shiftDecimal(amount string, exponent int) string {
+	if exponent == 0 {
+		return amount
+	}
+
+	if len(amount) <= exponent {
+		amount = strings.Repeat("0", exponent-len(amount)+1) + amount
+	}
+
+	integer, fractional := amount[:len(amount)-exponent], strings.TrimRight(amount[len(amount)-exponent:], "0")
+	if fractional == "" {
+		return integer
+	}
+	return integer + "." + fractional
+}
+
+// unshiftDecimal is the inverse of shiftDecimal, it fails if the result is
+// not an integer.
+func unshiftDecimal(dec string, exponent int) (string, error) {
+	integer, fractional := dec, ""
+	if i := strings.IndexByte(dec, '.'); i >= 0 {
+		integer, fractional = dec[:i], dec[i+1:]
+	}
+
+	if len(fractional) > exponent {
+		return "", fmt.Errorf("amount %s has more than %d decimals", dec, exponent)
+	}
+
+	amount, ok := new(big.Int).SetString(integer+fractional+strings.Repeat("0", exponent-len(fractional)), 10)
+	if !ok || amount.Sign() < 0 {
+		return "", fmt.Errorf("invalid amount %s", dec)
+	}
+
+	return amount.String(), nil
+}
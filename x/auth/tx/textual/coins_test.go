@@ -0,0 +1,90 @@
+package textual
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+type coinMetadata struct {
+	Base     string `json:"base"`
+	Display  string `json:"display"`
+	Exponent uint32 `json:"exponent"`
+}
+
+type coinTest struct {
+	Coin       sdk.Coin      `json:"coin"`
+	Metadata   *coinMetadata `json:"metadata"`
+	Text       string        `json:"text"`
+	Error      bool          `json:"error"`
+	ParseError bool          `json:"parse_error"`
+}
+
+// newMetadataQuerier returns a CoinMetadataQueryFn for the metadata of a
+// single denom.
+func newMetadataQuerier(m *coinMetadata) CoinMetadataQueryFn {
+	return func(_ context.Context, denom string) (*banktypes.Metadata, error) {
+		if m == nil || (denom != m.Base && denom != m.Display) {
+			return nil, nil
+		}
+
+		units := []*banktypes.DenomUnit{{Denom: m.Base}}
+		if m.Display != m.Base {
+			units = append(units, &banktypes.DenomUnit{Denom: m.Display, Exponent: m.Exponent})
+		}
+
+		return &banktypes.Metadata{Base: m.Base, Display: m.Display, DenomUnits: units}, nil
+	}
+}
+
+func TestCoinRenderer(t *testing.T) {
+	bz, err := os.ReadFile("internal/testdata/coins.json")
+	require.NoError(t, err)
+
+	var tests []coinTest
+	require.NoError(t, json.Unmarshal(bz, &tests))
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Text, func(t *testing.T) {
+			ctx := context.Background()
+			textual := NewTextual(newMetadataQuerier(tc.Metadata))
+
+			md, err := textual.registry.FindMessageByName(coinName)
+			require.NoError(t, err)
+			vr, err := textual.GetMessageValueRenderer(md)
+			require.NoError(t, err)
+
+			if !tc.ParseError {
+				coin, err := textual.registry.toDynamic(&tc.Coin)
+				require.NoError(t, err)
+
+				screens, err := vr.Format(ctx, protoreflect.ValueOfMessage(coin))
+				if tc.Error {
+					require.Error(t, err)
+					return
+				}
+				require.NoError(t, err)
+				require.Equal(t, []Screen{{Text: tc.Text}}, screens)
+			}
+
+			v, err := vr.Parse(ctx, []Screen{{Text: tc.Text}})
+			if tc.ParseError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			fields := md.Fields()
+			require.Equal(t, tc.Coin.Denom, v.Message().Get(fields.ByName("denom")).String())
+			require.Equal(t, tc.Coin.Amount.String(), v.Message().Get(fields.ByName("amount")).String())
+		})
+	}
+}
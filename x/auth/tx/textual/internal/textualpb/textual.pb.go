@@ -0,0 +1,1259 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/tx/textual/v1/textual.proto
+
+package textualpb
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
+	tx "github.com/cosmos/cosmos-sdk/types/tx"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Envelope is the data of a transaction and of its signer which is rendered
+// as screens by SIGN_MODE_TEXTUAL. Its fields are rendered in order, the
+// fields which are only shown in expert mode included.
+type Envelope struct {
+	// chain_id is the id of the chain the transaction is for.
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// account_number is the account number of the signer.
+	AccountNumber uint64 `protobuf:"varint,2,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	// sequence is the account sequence of the signer.
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// address is the address of the signer.
+	Address string `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	// public_key is the public key of the signer.
+	PublicKey *types.Any `protobuf:"bytes,5,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// message is the list of messages of the transaction.
+	Message []*types.Any `protobuf:"bytes,6,rep,name=message,proto3" json:"message,omitempty"`
+	// memo is the memo of the transaction.
+	Memo string `protobuf:"bytes,7,opt,name=memo,proto3" json:"memo,omitempty"`
+	// fees are the fees of the transaction.
+	Fees []types1.Coin `protobuf:"bytes,8,rep,name=fees,proto3" json:"fees"`
+	// fee_payer is the address of the fee payer if it is not the first signer.
+	FeePayer string `protobuf:"bytes,9,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty"`
+	// fee_granter is the address of the fee granter, if any.
+	FeeGranter string `protobuf:"bytes,10,opt,name=fee_granter,json=feeGranter,proto3" json:"fee_granter,omitempty"`
+	// tip is the tip of the transaction, if any.
+	Tip []types1.Coin `protobuf:"bytes,11,rep,name=tip,proto3" json:"tip"`
+	// tipper is the address of the account paying for the tip, if any.
+	Tipper string `protobuf:"bytes,12,opt,name=tipper,proto3" json:"tipper,omitempty"`
+	// gas_limit is the gas limit of the transaction.
+	GasLimit uint64 `protobuf:"varint,13,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// timeout_height is the timeout height of the transaction, if any.
+	TimeoutHeight uint64 `protobuf:"varint,14,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height,omitempty"`
+	// other_signer is the list of the signer infos of the other signers.
+	OtherSigner []*tx.SignerInfo `protobuf:"bytes,15,rep,name=other_signer,json=otherSigner,proto3" json:"other_signer,omitempty"`
+	// extension_option is the list of the extension options of the transaction.
+	ExtensionOption []*types.Any `protobuf:"bytes,16,rep,name=extension_option,json=extensionOption,proto3" json:"extension_option,omitempty"`
+	// non_critical_extension_option is the list of the non-critical extension
+	// options of the transaction.
+	NonCriticalExtensionOption []*types.Any `protobuf:"bytes,17,rep,name=non_critical_extension_option,json=nonCriticalExtensionOption,proto3" json:"non_critical_extension_option,omitempty"`
+	// hash_of_raw_bytes is the hex-encoded SHA-256 hash of the body bytes and
+	// auth info bytes of the transaction, so that the signature covers the
+	// exact bytes of the transaction.
+	HashOfRawBytes string `protobuf:"bytes,18,opt,name=hash_of_raw_bytes,json=hashOfRawBytes,proto3" json:"hash_of_raw_bytes,omitempty"`
+}
+
+func (m *Envelope) Reset()         { *m = Envelope{} }
+func (m *Envelope) String() string { return proto.CompactTextString(m) }
+func (*Envelope) ProtoMessage()    {}
+func (*Envelope) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7bc2faebafe7e9a5, []int{0}
+}
+func (m *Envelope) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Envelope) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Envelope.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Envelope) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Envelope.Merge(m, src)
+}
+func (m *Envelope) XXX_Size() int {
+	return m.Size()
+}
+func (m *Envelope) XXX_DiscardUnknown() {
+	xxx_messageInfo_Envelope.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Envelope proto.InternalMessageInfo
+
+func (m *Envelope) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *Envelope) GetAccountNumber() uint64 {
+	if m != nil {
+		return m.AccountNumber
+	}
+	return 0
+}
+
+func (m *Envelope) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *Envelope) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Envelope) GetPublicKey() *types.Any {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *Envelope) GetMessage() []*types.Any {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *Envelope) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *Envelope) GetFees() []types1.Coin {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
+func (m *Envelope) GetFeePayer() string {
+	if m != nil {
+		return m.FeePayer
+	}
+	return ""
+}
+
+func (m *Envelope) GetFeeGranter() string {
+	if m != nil {
+		return m.FeeGranter
+	}
+	return ""
+}
+
+func (m *Envelope) GetTip() []types1.Coin {
+	if m != nil {
+		return m.Tip
+	}
+	return nil
+}
+
+func (m *Envelope) GetTipper() string {
+	if m != nil {
+		return m.Tipper
+	}
+	return ""
+}
+
+func (m *Envelope) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *Envelope) GetTimeoutHeight() uint64 {
+	if m != nil {
+		return m.TimeoutHeight
+	}
+	return 0
+}
+
+func (m *Envelope) GetOtherSigner() []*tx.SignerInfo {
+	if m != nil {
+		return m.OtherSigner
+	}
+	return nil
+}
+
+func (m *Envelope) GetExtensionOption() []*types.Any {
+	if m != nil {
+		return m.ExtensionOption
+	}
+	return nil
+}
+
+func (m *Envelope) GetNonCriticalExtensionOption() []*types.Any {
+	if m != nil {
+		return m.NonCriticalExtensionOption
+	}
+	return nil
+}
+
+func (m *Envelope) GetHashOfRawBytes() string {
+	if m != nil {
+		return m.HashOfRawBytes
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Envelope)(nil), "cosmos.tx.textual.v1.Envelope")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/tx/textual/v1/textual.proto", fileDescriptor_7bc2faebafe7e9a5)
+}
+
+var fileDescriptor_7bc2faebafe7e9a5 = []byte{
+	// 633 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcd, 0x6e, 0xd4, 0x30,
+	0x10, 0xc7, 0x77, 0xe9, 0xb2, 0x1f, 0xde, 0x7e, 0x5a, 0x3d, 0xb8, 0x8b, 0x1a, 0x56, 0x95, 0x90,
+	0xca, 0xa1, 0x09, 0xdb, 0x8a, 0x03, 0x27, 0xe8, 0x56, 0x15, 0x54, 0x20, 0x8a, 0xd2, 0x03, 0x12,
+	0x1c, 0x2c, 0x27, 0x3b, 0x49, 0xac, 0x26, 0x76, 0x88, 0x9d, 0xed, 0xee, 0x5b, 0xf0, 0x30, 0x3c,
+	0x44, 0x8f, 0x15, 0x27, 0x4e, 0x08, 0xb5, 0x57, 0x1e, 0x02, 0xc5, 0x49, 0x5a, 0x04, 0xa2, 0xe5,
+	0x14, 0xcf, 0xfc, 0x7f, 0x33, 0x9e, 0xf1, 0xd8, 0x41, 0x5b, 0xbe, 0x54, 0x89, 0x54, 0x8e, 0x9e,
+	0x39, 0x1a, 0x66, 0x3a, 0x67, 0xb1, 0x33, 0x1d, 0xd5, 0x4b, 0x3b, 0xcd, 0xa4, 0x96, 0x78, 0xbd,
+	0x64, 0x6c, 0x3d, 0xb3, 0x6b, 0x61, 0x3a, 0x1a, 0xac, 0x87, 0x32, 0x94, 0x06, 0x70, 0x8a, 0x55,
+	0xc9, 0x0e, 0xac, 0x2a, 0x9f, 0xc7, 0x14, 0x38, 0xd3, 0x91, 0x07, 0x9a, 0x8d, 0x1c, 0x5f, 0x72,
+	0x51, 0xe9, 0x83, 0x9b, 0xfd, 0x6a, 0x55, 0xcf, 0x2a, 0x6d, 0x23, 0x94, 0x32, 0x8c, 0xc1, 0x31,
+	0x96, 0x97, 0x07, 0x0e, 0x13, 0xf3, 0x5a, 0x2a, 0xc3, 0x68, 0xb9, 0x5f, 0x55, 0x8f, 0x31, 0xb6,
+	0x7e, 0xb6, 0x51, 0xf7, 0x50, 0x4c, 0x21, 0x96, 0x29, 0xe0, 0x0d, 0xd4, 0xf5, 0x23, 0xc6, 0x05,
+	0xe5, 0x13, 0xd2, 0x1c, 0x36, 0xb7, 0x7b, 0x6e, 0xc7, 0xd8, 0x47, 0x13, 0xfc, 0x08, 0x2d, 0x33,
+	0xdf, 0x97, 0xb9, 0xd0, 0x54, 0xe4, 0x89, 0x07, 0x19, 0xb9, 0x37, 0x6c, 0x6e, 0xb7, 0xdc, 0xa5,
+	0xca, 0xfb, 0xd6, 0x38, 0xf1, 0x00, 0x75, 0x15, 0x7c, 0xca, 0x41, 0xf8, 0x40, 0x16, 0x0c, 0x70,
+	0x6d, 0xe3, 0x5d, 0xd4, 0x61, 0x93, 0x49, 0x06, 0x4a, 0x91, 0x56, 0x91, 0x7c, 0x4c, 0xbe, 0x7e,
+	0xd9, 0xa9, 0x4f, 0x67, 0xbf, 0x54, 0x4e, 0x74, 0xc6, 0x45, 0xe8, 0xd6, 0x20, 0xde, 0x43, 0x28,
+	0xcd, 0xbd, 0x98, 0xfb, 0xf4, 0x14, 0xe6, 0xe4, 0xfe, 0xb0, 0xb9, 0xdd, 0xdf, 0x5d, 0xb7, 0xcb,
+	0x4e, 0xed, 0xba, 0x53, 0x7b, 0x5f, 0xcc, 0xdd, 0x5e, 0xc9, 0xbd, 0x86, 0x39, 0xb6, 0x51, 0x27,
+	0x01, 0xa5, 0x58, 0x08, 0xa4, 0x3d, 0x5c, 0xf8, 0x67, 0x44, 0x0d, 0x61, 0x8c, 0x5a, 0x09, 0x24,
+	0x92, 0x74, 0x4c, 0xcb, 0x66, 0x8d, 0xf7, 0x50, 0x2b, 0x00, 0x50, 0xa4, 0x6b, 0x12, 0x6c, 0xd8,
+	0x55, 0x99, 0xc5, 0x60, 0xec, 0xea, 0xe8, 0xed, 0x03, 0xc9, 0xc5, 0xb8, 0x75, 0xfe, 0xfd, 0x61,
+	0xc3, 0x35, 0x30, 0x7e, 0x8a, 0x7a, 0x01, 0x00, 0x4d, 0xd9, 0x1c, 0x32, 0xd2, 0xbb, 0xa3, 0xc7,
+	0x6e, 0x00, 0xf0, 0xae, 0x20, 0xf1, 0x33, 0xd4, 0x2f, 0xc2, 0xc2, 0x8c, 0x09, 0x0d, 0x19, 0x41,
+	0x77, 0x04, 0xa2, 0x00, 0xe0, 0x65, 0xc9, 0xe2, 0x11, 0x5a, 0xd0, 0x3c, 0x25, 0xfd, 0xff, 0xab,
+	0xb2, 0x60, 0xf1, 0x13, 0xd4, 0xd6, 0x3c, 0x4d, 0x21, 0x23, 0x8b, 0x77, 0x6c, 0x54, 0x71, 0xf8,
+	0x01, 0xea, 0x85, 0x4c, 0xd1, 0x98, 0x27, 0x5c, 0x93, 0xa5, 0x72, 0xaa, 0x21, 0x53, 0x6f, 0x0a,
+	0xbb, 0xb8, 0x18, 0x9a, 0x27, 0x20, 0x73, 0x4d, 0x23, 0xe0, 0x61, 0xa4, 0xc9, 0x72, 0x79, 0x31,
+	0x2a, 0xef, 0x2b, 0xe3, 0xc4, 0x2f, 0xd0, 0xa2, 0xd4, 0x11, 0x64, 0x54, 0xf1, 0x50, 0x40, 0x46,
+	0x56, 0x4c, 0xc5, 0x9b, 0xf6, 0xcd, 0xe3, 0xa8, 0xeb, 0x3d, 0x31, 0xc0, 0x91, 0x08, 0xa4, 0xdb,
+	0x37, 0x21, 0xa5, 0x03, 0x3f, 0x47, 0xab, 0x30, 0xd3, 0x20, 0x14, 0x97, 0x82, 0xca, 0x54, 0x73,
+	0x29, 0xc8, 0xea, 0x2d, 0xe3, 0x5d, 0xb9, 0xa6, 0x8f, 0x0d, 0x8c, 0xdf, 0xa3, 0x4d, 0x21, 0x05,
+	0xf5, 0x33, 0xae, 0xb9, 0xcf, 0x62, 0xfa, 0x57, 0xb6, 0xb5, 0x5b, 0xb2, 0x0d, 0x84, 0x14, 0x07,
+	0x55, 0xe4, 0xe1, 0x1f, 0x89, 0x1f, 0xa3, 0xb5, 0x88, 0xa9, 0x88, 0xca, 0x80, 0x66, 0xec, 0x8c,
+	0x7a, 0x73, 0x0d, 0x8a, 0x60, 0x73, 0x99, 0x96, 0x0b, 0xe1, 0x38, 0x70, 0xd9, 0xd9, 0xb8, 0xf0,
+	0x8e, 0x3f, 0x9e, 0x5f, 0x5a, 0xcd, 0x8b, 0x4b, 0xab, 0xf9, 0xe3, 0xd2, 0x6a, 0x7e, 0xbe, 0xb2,
+	0x1a, 0x17, 0x57, 0x56, 0xe3, 0xdb, 0x95, 0xd5, 0xf8, 0xb0, 0x1f, 0x72, 0x1d, 0xe5, 0x9e, 0xed,
+	0xcb, 0xa4, 0x7a, 0xa1, 0xd5, 0x67, 0x47, 0x4d, 0x4e, 0x9d, 0x99, 0xc3, 0x72, 0x1d, 0xfd, 0xfe,
+	0x9f, 0xe1, 0xc5, 0xfc, 0x05, 0x8b, 0x6b, 0x47, 0xea, 0x79, 0x6d, 0x53, 0xf1, 0xde, 0xaf, 0x01,
+	0x00, 0xed, 0xe1, 0xe2, 0x47, 0x96, 0x04, 0x00, 0x00,
+}
+
+func (m *Envelope) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Envelope) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Envelope) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HashOfRawBytes) > 0 {
+		i -= len(m.HashOfRawBytes)
+		copy(dAtA[i:], m.HashOfRawBytes)
+		i = encodeVarintTextual(dAtA, i, uint64(len(m.HashOfRawBytes)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.NonCriticalExtensionOption) > 0 {
+		for iNdEx := len(m.NonCriticalExtensionOption) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NonCriticalExtensionOption[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTextual(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.ExtensionOption) > 0 {
+		for iNdEx := len(m.ExtensionOption) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExtensionOption[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTextual(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.OtherSigner) > 0 {
+		for iNdEx := len(m.OtherSigner) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OtherSigner[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTextual(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.TimeoutHeight != 0 {
+		i = encodeVarintTextual(dAtA, i, uint64(m.TimeoutHeight))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintTextual(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.Tipper) > 0 {
+		i -= len(m.Tipper)
+		copy(dAtA[i:], m.Tipper)
+		i = encodeVarintTextual(dAtA, i, uint64(len(m.Tipper)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.Tip) > 0 {
+		for iNdEx := len(m.Tip) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tip[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTextual(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.FeeGranter) > 0 {
+		i -= len(m.FeeGranter)
+		copy(dAtA[i:], m.FeeGranter)
+		i = encodeVarintTextual(dAtA, i, uint64(len(m.FeeGranter)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.FeePayer) > 0 {
+		i -= len(m.FeePayer)
+		copy(dAtA[i:], m.FeePayer)
+		i = encodeVarintTextual(dAtA, i, uint64(len(m.FeePayer)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTextual(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintTextual(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Message) > 0 {
+		for iNdEx := len(m.Message) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Message[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTextual(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.PublicKey != nil {
+		{
+			size, err := m.PublicKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTextual(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTextual(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintTextual(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AccountNumber != 0 {
+		i = encodeVarintTextual(dAtA, i, uint64(m.AccountNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTextual(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTextual(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTextual(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Envelope) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTextual(uint64(l))
+	}
+	if m.AccountNumber != 0 {
+		n += 1 + sovTextual(uint64(m.AccountNumber))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovTextual(uint64(m.Sequence))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTextual(uint64(l))
+	}
+	if m.PublicKey != nil {
+		l = m.PublicKey.Size()
+		n += 1 + l + sovTextual(uint64(l))
+	}
+	if len(m.Message) > 0 {
+		for _, e := range m.Message {
+			l = e.Size()
+			n += 1 + l + sovTextual(uint64(l))
+		}
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovTextual(uint64(l))
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovTextual(uint64(l))
+		}
+	}
+	l = len(m.FeePayer)
+	if l > 0 {
+		n += 1 + l + sovTextual(uint64(l))
+	}
+	l = len(m.FeeGranter)
+	if l > 0 {
+		n += 1 + l + sovTextual(uint64(l))
+	}
+	if len(m.Tip) > 0 {
+		for _, e := range m.Tip {
+			l = e.Size()
+			n += 1 + l + sovTextual(uint64(l))
+		}
+	}
+	l = len(m.Tipper)
+	if l > 0 {
+		n += 1 + l + sovTextual(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTextual(uint64(m.GasLimit))
+	}
+	if m.TimeoutHeight != 0 {
+		n += 1 + sovTextual(uint64(m.TimeoutHeight))
+	}
+	if len(m.OtherSigner) > 0 {
+		for _, e := range m.OtherSigner {
+			l = e.Size()
+			n += 1 + l + sovTextual(uint64(l))
+		}
+	}
+	if len(m.ExtensionOption) > 0 {
+		for _, e := range m.ExtensionOption {
+			l = e.Size()
+			n += 2 + l + sovTextual(uint64(l))
+		}
+	}
+	if len(m.NonCriticalExtensionOption) > 0 {
+		for _, e := range m.NonCriticalExtensionOption {
+			l = e.Size()
+			n += 2 + l + sovTextual(uint64(l))
+		}
+	}
+	l = len(m.HashOfRawBytes)
+	if l > 0 {
+		n += 2 + l + sovTextual(uint64(l))
+	}
+	return n
+}
+
+func sovTextual(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTextual(x uint64) (n int) {
+	return sovTextual(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Envelope) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTextual
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Envelope: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Envelope: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTextual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTextual
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTextual
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountNumber", wireType)
+			}
+			m.AccountNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTextual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTextual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTextual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTextual
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTextual
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTextual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTextual
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTextual
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PublicKey == nil {
+				m.PublicKey = &types.Any{}
+			}
+			if err := m.PublicKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTextual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTextual
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTextual
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = append(m.Message, &types.Any{})
+			if err := m.Message[len(m.Message)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTextual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTextual
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTextual
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTextual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTextual
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTextual
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types1.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTextual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTextual
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTextual
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGranter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTextual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTextual
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTextual
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeGranter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tip", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTextual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTextual
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTextual
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tip = append(m.Tip, types1.Coin{})
+			if err := m.Tip[len(m.Tip)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tipper", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTextual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTextual
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTextual
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tipper = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTextual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
+			}
+			m.TimeoutHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTextual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OtherSigner", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTextual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTextual
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTextual
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OtherSigner = append(m.OtherSigner, &tx.SignerInfo{})
+			if err := m.OtherSigner[len(m.OtherSigner)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionOption", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTextual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTextual
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTextual
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtensionOption = append(m.ExtensionOption, &types.Any{})
+			if err := m.ExtensionOption[len(m.ExtensionOption)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonCriticalExtensionOption", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTextual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTextual
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTextual
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NonCriticalExtensionOption = append(m.NonCriticalExtensionOption, &types.Any{})
+			if err := m.NonCriticalExtensionOption[len(m.NonCriticalExtensionOption)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashOfRawBytes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTextual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTextual
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTextual
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HashOfRawBytes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTextual(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTextual
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTextual(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTextual
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTextual
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTextual
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTextual
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTextual
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTextual
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTextual        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTextual          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTextual = fmt.Errorf("proto: unexpected end of group")
+)
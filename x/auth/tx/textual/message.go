@@ -0,0 +1,335 @@
+package textual
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// messageRenderer renders messages as a header screen "<Name> object"
+// followed by the screens of their populated fields in field number order at
+// indent 1. Each field is rendered as "<Title>: <first screen of the value>"
+// followed by the remaining screens of the value, see formatField.
+type messageRenderer struct {
+	t  Textual
+	md protoreflect.MessageDescriptor
+}
+
+func (r messageRenderer) header() string {
+	return string(r.md.Name()) + " object"
+}
+
+func (r messageRenderer) Format(ctx context.Context, v protoreflect.Value) ([]Screen, error) {
+	screens, err := r.formatFields(ctx, v.Message())
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range screens {
+		screens[i].Indent++
+	}
+
+	return append([]Screen{{Text: r.header()}}, screens...), nil
+}
+
+func (r messageRenderer) Parse(ctx context.Context, screens []Screen) (protoreflect.Value, error) {
+	if len(screens) == 0 || screens[0].Text != r.header() || screens[0].Indent != 0 {
+		return protoreflect.Value{}, fmt.Errorf("expected %q header screen", r.header())
+	}
+
+	fieldScreens, err := unindent(screens[1:])
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+
+	msg, err := r.parseFields(ctx, fieldScreens)
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+
+	return protoreflect.ValueOfMessage(msg), nil
+}
+
+// formatFields renders the populated fields of msg as screens, the first
+// screen of each field being at indent 0.
+func (r messageRenderer) formatFields(ctx context.Context, msg protoreflect.Message) ([]Screen, error) {
+	var screens []Screen
+	for _, fd := range sortedFields(r.md) {
+		if !msg.Has(fd) {
+			continue
+		}
+
+		fieldScreens, err := r.t.formatField(ctx, fd, msg.Get(fd))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", fd.Name(), err)
+		}
+		screens = append(screens, fieldScreens...)
+	}
+
+	return screens, nil
+}
+
+// parseFields is the inverse of formatFields.
+func (r messageRenderer) parseFields(ctx context.Context, screens []Screen) (protoreflect.Message, error) {
+	msg := dynamicpb.NewMessage(r.md)
+
+	fieldsByTitle := make(map[string]protoreflect.FieldDescriptor)
+	for _, fd := range sortedFields(r.md) {
+		fieldsByTitle[fieldTitle(fd)] = fd
+	}
+
+	var lastNumber protoreflect.FieldNumber
+	for len(screens) > 0 {
+		title, _, ok := splitTitle(screens[0])
+		if !ok {
+			return nil, fmt.Errorf("expected field screen, got %q", screens[0].Text)
+		}
+
+		fd, ok := fieldsByTitle[title]
+		if !ok {
+			return nil, fmt.Errorf("unknown field %q in %s", title, r.md.FullName())
+		}
+		if fd.Number() <= lastNumber {
+			return nil, fmt.Errorf("field %q is out of order or repeated", title)
+		}
+		lastNumber = fd.Number()
+
+		n := valueLength(screens)
+		if fd.IsList() && !isCoins(fd) {
+			n = listLength(screens, fd)
+		}
+
+		v, err := r.t.parseField(ctx, fd, msg, screens[:n])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", fd.Name(), err)
+		}
+		msg.Set(fd, v)
+		screens = screens[n:]
+	}
+
+	return msg, nil
+}
+
+// formatField renders the value of the field fd. Lists are rendered as
+// "<Title>: <n> <element type>", then each element at indent 1 like a field
+// titled "<Title> (<i>/<n>)", and finally "End of <Title>", except for lists
+// of coins which are rendered on a single screen.
+func (t Textual) formatField(ctx context.Context, fd protoreflect.FieldDescriptor, v protoreflect.Value) ([]Screen, error) {
+	title := fieldTitle(fd)
+
+	if fd.IsMap() {
+		return nil, fmt.Errorf("map fields are not supported")
+	}
+
+	if fd.IsList() && isCoins(fd) {
+		screens, err := coinsRenderer{t: t, md: fd.Message()}.Format(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		return titled(title, screens), nil
+	}
+
+	vr, err := t.GetValueRenderer(fd)
+	if err != nil {
+		return nil, err
+	}
+
+	if !fd.IsList() {
+		screens, err := vr.Format(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		return titled(title, screens), nil
+	}
+
+	list := v.List()
+	screens := []Screen{{Text: fmt.Sprintf("%s: %d %s", title, list.Len(), elementType(fd))}}
+	for i := 0; i < list.Len(); i++ {
+		elemScreens, err := vr.Format(ctx, list.Get(i))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, s := range titled(fmt.Sprintf("%s (%d/%d)", title, i+1, list.Len()), elemScreens) {
+			s.Indent++
+			screens = append(screens, s)
+		}
+	}
+
+	return append(screens, Screen{Text: "End of " + title}), nil
+}
+
+// parseField is the inverse of formatField.
+func (t Textual) parseField(ctx context.Context, fd protoreflect.FieldDescriptor, msg protoreflect.Message, screens []Screen) (protoreflect.Value, error) {
+	title := fieldTitle(fd)
+
+	if fd.IsList() && isCoins(fd) {
+		list := msg.NewField(fd).List()
+		value, err := untitled(title, screens)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		return coinsRenderer{t: t, md: fd.Message()}.parse(ctx, value, list)
+	}
+
+	vr, err := t.GetValueRenderer(fd)
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+
+	if !fd.IsList() {
+		value, err := untitled(title, screens)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		return vr.Parse(ctx, value)
+	}
+
+	header := fmt.Sprintf("%s: ", title)
+	count, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(screens[0].Text, header), " "+elementType(fd)))
+	if err != nil || screens[0].Text != fmt.Sprintf("%s: %d %s", title, count, elementType(fd)) || count <= 0 {
+		return protoreflect.Value{}, fmt.Errorf("invalid list header %q", screens[0].Text)
+	}
+
+	last := screens[len(screens)-1]
+	if last.Text != "End of "+title || last.Indent != 0 {
+		return protoreflect.Value{}, fmt.Errorf("expected %q screen", "End of "+title)
+	}
+
+	elems, err := unindent(screens[1 : len(screens)-1])
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+
+	list := msg.NewField(fd).List()
+	for i := 0; i < count; i++ {
+		if len(elems) == 0 {
+			return protoreflect.Value{}, fmt.Errorf("expected %d elements, got %d", count, i)
+		}
+
+		n := valueLength(elems)
+		value, err := untitled(fmt.Sprintf("%s (%d/%d)", title, i+1, count), elems[:n])
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+
+		elem, err := vr.Parse(ctx, value)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		list.Append(elem)
+		elems = elems[n:]
+	}
+
+	if len(elems) != 0 {
+		return protoreflect.Value{}, fmt.Errorf("expected %d elements, got more", count)
+	}
+
+	return protoreflect.ValueOfList(list), nil
+}
+
+// fieldTitle returns the title of a field, its name in sentence case, e.g.
+// "From address" for from_address.
+func fieldTitle(fd protoreflect.FieldDescriptor) string {
+	name := strings.ReplaceAll(string(fd.Name()), "_", " ")
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+// elementType returns the name of the type of the elements of a list field.
+func elementType(fd protoreflect.FieldDescriptor) string {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return string(fd.Message().Name())
+	case protoreflect.EnumKind:
+		return string(fd.Enum().Name())
+	default:
+		return fd.Kind().String()
+	}
+}
+
+// sortedFields returns the fields of md in field number order.
+func sortedFields(md protoreflect.MessageDescriptor) []protoreflect.FieldDescriptor {
+	fields := make([]protoreflect.FieldDescriptor, md.Fields().Len())
+	for i := range fields {
+		fields[i] = md.Fields().Get(i)
+	}
+	sort.Slice(fields, func(i, j int) bool { return fields[i].Number() < fields[j].Number() })
+	return fields
+}
+
+// titled prefixes the first screen of a value, which is at indent 0, with the
+// title.
+func titled(title string, screens []Screen) []Screen {
+	screens[0].Text = fmt.Sprintf("%s: %s", title, screens[0].Text)
+	return screens
+}
+
+// untitled is the inverse of titled.
+func untitled(title string, screens []Screen) ([]Screen, error) {
+	prefix := title + ": "
+	if len(screens) == 0 || screens[0].Indent != 0 || !strings.HasPrefix(screens[0].Text, prefix) {
+		return nil, fmt.Errorf("expected %q screen", prefix)
+	}
+
+	res := make([]Screen, len(screens))
+	copy(res, screens)
+	res[0].Text = strings.TrimPrefix(res[0].Text, prefix)
+	return res, nil
+}
+
+// splitTitle splits the text of a field screen into its title and value.
+func splitTitle(s Screen) (title, value string, ok bool) {
+	if s.Indent != 0 {
+		return "", "", false
+	}
+	i := strings.Index(s.Text, ": ")
+	if i < 0 {
+		return "", "", false
+	}
+	return s.Text[:i], s.Text[i+2:], true
+}
+
+// valueLength returns the number of screens of the value starting at the
+// first screen, i.e. the first screen and the following ones at a greater
+// indent.
+func valueLength(screens []Screen) int {
+	n := 1
+	for n < len(screens) && screens[n].Indent > screens[0].Indent {
+		n++
+	}
+	return n
+}
+
+// listLength returns the number of screens of the list starting at the first
+// screen, up to and including its "End of" screen.
+func listLength(screens []Screen, fd protoreflect.FieldDescriptor) int {
+	end := "End of " + fieldTitle(fd)
+	for n := 1; n < len(screens); n++ {
+		if screens[n].Indent == screens[0].Indent && screens[n].Text == end {
+			return n + 1
+		}
+		if screens[n].Indent <= screens[0].Indent {
+			break
+		}
+	}
+	return len(screens)
+}
+
+// unindent decrements the indent of the screens, which must all be at indent
+// 1 or more.
+func unindent(screens []Screen) ([]Screen, error) {
+	res := make([]Screen, len(screens))
+	for i, s := range screens {
+		if s.Indent < 1 {
+			return nil, fmt.Errorf("unexpected screen %q at indent %d", s.Text, s.Indent)
+		}
+		s.Indent--
+		res[i] = s
+	}
+	return res, nil
+}
@@ -0,0 +1,192 @@
+package textual
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"sync"
+
+	gogoproto "github.com/gogo/protobuf/proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	// register the cosmos_proto options so that they are parsed from the
+	// gogoproto file descriptors
+	_ "github.com/cosmos/cosmos-proto"
+	// register the well-known types which are taken from the global registry
+	_ "google.golang.org/protobuf/types/known/anypb"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type descriptorIface interface {
+	Descriptor() ([]byte, []int)
+}
+
+// fileRegistry builds protoreflect descriptors from the file descriptors of
+// the gogoproto registry, which the SDK types are registered in, so that
+// transactions can be rendered and parsed generically as dynamic messages.
+type fileRegistry struct {
+	mu    sync.Mutex
+	files *protoregistry.Files
+}
+
+func newFileRegistry() *fileRegistry {
+	return &fileRegistry{files: new(protoregistry.Files)}
+}
+
+// FindMessageByName returns the descriptor of the message with the provided
+// fully-qualified name.
+func (r *fileRegistry) FindMessageByName(name protoreflect.FullName) (protoreflect.MessageDescriptor, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, err := r.files.FindDescriptorByName(name); err == nil {
+		return r.findMessage(name)
+	}
+
+	if strings.HasPrefix(string(name), "google.protobuf.") {
+		if desc, err := protoregistry.GlobalFiles.FindDescriptorByName(name); err == nil {
+			if err := r.files.RegisterFile(desc.ParentFile()); err != nil {
+				return nil, err
+			}
+			return r.findMessage(name)
+		}
+	}
+
+	typ := gogoproto.MessageType(string(name))
+	if typ == nil {
+		return nil, fmt.Errorf("message %s is not registered", name)
+	}
+
+	msg, ok := reflect.New(typ.Elem()).Interface().(descriptorIface)
+	if !ok {
+		return nil, fmt.Errorf("message %s has no file descriptor", name)
+	}
+
+	gzipped, _ := msg.Descriptor()
+	fdp, err := unzipFileDescriptor(gzipped)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.registerFile(fdp); err != nil {
+		return nil, err
+	}
+
+	return r.findMessage(name)
+}
+
+func (r *fileRegistry) findMessage(name protoreflect.FullName) (protoreflect.MessageDescriptor, error) {
+	desc, err := r.files.FindDescriptorByName(name)
+	if err != nil {
+		return nil, err
+	}
+	md, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a message", name)
+	}
+	return md, nil
+}
+
+// FindMessageByURL returns the descriptor of the message with the provided
+// google.protobuf.Any type URL.
+func (r *fileRegistry) FindMessageByURL(url string) (protoreflect.MessageDescriptor, error) {
+	name := url
+	if i := strings.LastIndexByte(url, '/'); i >= 0 {
+		name = url[i+1:]
+	}
+	return r.FindMessageByName(protoreflect.FullName(name))
+}
+
+// registerFile registers the file and its dependencies. The well-known types
+// are taken from the global registry. Dependencies which can't be found, like
+// gogoproto/gogo.proto which only defines options, are left unresolved.
+func (r *fileRegistry) registerFile(fdp *descriptorpb.FileDescriptorProto) error {
+	if _, err := r.files.FindFileByPath(fdp.GetName()); err == nil {
+		return nil
+	}
+
+	for _, dep := range fdp.Dependency {
+		if _, err := r.files.FindFileByPath(dep); err == nil {
+			continue
+		}
+
+		if strings.HasPrefix(dep, "google/protobuf/") {
+			fd, err := protoregistry.GlobalFiles.FindFileByPath(dep)
+			if err == nil {
+				if err := r.files.RegisterFile(fd); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+
+		gzipped := gogoproto.FileDescriptor(dep)
+		if gzipped == nil {
+			continue
+		}
+
+		depFdp, err := unzipFileDescriptor(gzipped)
+		if err != nil {
+			return err
+		}
+
+		if err := r.registerFile(depFdp); err != nil {
+			return err
+		}
+	}
+
+	fd, err := protodesc.FileOptions{AllowUnresolvable: true}.New(fdp, r.files)
+	if err != nil {
+		return err
+	}
+
+	return r.files.RegisterFile(fd)
+}
+
+func unzipFileDescriptor(gzipped []byte) (*descriptorpb.FileDescriptorProto, error) {
+	gzr, err := gzip.NewReader(bytes.NewReader(gzipped))
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := io.ReadAll(gzr)
+	if err != nil {
+		return nil, err
+	}
+
+	fdp := new(descriptorpb.FileDescriptorProto)
+	if err := proto.Unmarshal(bz, fdp); err != nil {
+		return nil, err
+	}
+
+	return fdp, nil
+}
+
+// toDynamic converts a gogoproto message to a dynamic message.
+func (r *fileRegistry) toDynamic(msg gogoproto.Message) (*dynamicpb.Message, error) {
+	md, err := r.FindMessageByName(protoreflect.FullName(gogoproto.MessageName(msg)))
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := gogoproto.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	dyn := dynamicpb.NewMessage(md)
+	if err := proto.Unmarshal(bz, dyn); err != nil {
+		return nil, err
+	}
+
+	return dyn, nil
+}
@@ -0,0 +1,226 @@
+package textual
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	cosmos_proto "github.com/cosmos/cosmos-proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+const addressScalar = "cosmos.AddressString"
+
+// isAddress returns whether the field is annotated as a bech32 address.
+func isAddress(fd protoreflect.FieldDescriptor) bool {
+	opts, ok := fd.Options().(*descriptorpb.FieldOptions)
+	if !ok || opts == nil {
+		return false
+	}
+
+	scalar, ok := proto.GetExtension(opts, cosmos_proto.E_Scalar).(string)
+	return ok && scalar == addressScalar
+}
+
+// singleScreen returns the text of screens, which must be a single screen
+// at indent 0.
+func singleScreen(screens []Screen) (string, error) {
+	if len(screens) != 1 {
+		return "", fmt.Errorf("expected 1 screen, got %d", len(screens))
+	}
+	if screens[0].Indent != 0 {
+		return "", fmt.Errorf("expected screen at indent 0, got %d", screens[0].Indent)
+	}
+	return screens[0].Text, nil
+}
+
+type stringRenderer struct{}
+
+func (stringRenderer) Format(_ context.Context, v protoreflect.Value) ([]Screen, error) {
+	return []Screen{{Text: v.String()}}, nil
+}
+
+func (stringRenderer) Parse(_ context.Context, screens []Screen) (protoreflect.Value, error) {
+	text, err := singleScreen(screens)
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+	return protoreflect.ValueOfString(text), nil
+}
+
+// addressRenderer renders bech32 addresses as they are, but only valid ones
+// so that invalid addresses can't be mistaken for valid ones by users.
+type addressRenderer struct{}
+
+func (addressRenderer) Format(_ context.Context, v protoreflect.Value) ([]Screen, error) {
+	if _, _, err := bech32.DecodeAndConvert(v.String()); err != nil {
+		return nil, fmt.Errorf("invalid address %q: %w", v.String(), err)
+	}
+	return []Screen{{Text: v.String()}}, nil
+}
+
+func (addressRenderer) Parse(_ context.Context, screens []Screen) (protoreflect.Value, error) {
+	text, err := singleScreen(screens)
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+	if _, _, err := bech32.DecodeAndConvert(text); err != nil {
+		return protoreflect.Value{}, fmt.Errorf("invalid address %q: %w", text, err)
+	}
+	return protoreflect.ValueOfString(text), nil
+}
+
+// bytesRenderer renders bytes in upper case hexadecimal.
+type bytesRenderer struct{}
+
+func (bytesRenderer) Format(_ context.Context, v protoreflect.Value) ([]Screen, error) {
+	return []Screen{{Text: strings.ToUpper(hex.EncodeToString(v.Bytes()))}}, nil
+}
+
+func (bytesRenderer) Parse(_ context.Context, screens []Screen) (protoreflect.Value, error) {
+	text, err := singleScreen(screens)
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+	if text != strings.ToUpper(text) {
+		return protoreflect.Value{}, fmt.Errorf("expected upper case hexadecimal, got %q", text)
+	}
+	bz, err := hex.DecodeString(text)
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+	return protoreflect.ValueOfBytes(bz), nil
+}
+
+type boolRenderer struct{}
+
+func (boolRenderer) Format(_ context.Context, v protoreflect.Value) ([]Screen, error) {
+	if v.Bool() {
+		return []Screen{{Text: "True"}}, nil
+	}
+	return []Screen{{Text: "False"}}, nil
+}
+
+func (boolRenderer) Parse(_ context.Context, screens []Screen) (protoreflect.Value, error) {
+	text, err := singleScreen(screens)
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+	switch text {
+	case "True":
+		return protoreflect.ValueOfBool(true), nil
+	case "False":
+		return protoreflect.ValueOfBool(false), nil
+	default:
+		return protoreflect.Value{}, fmt.Errorf("invalid boolean %q", text)
+	}
+}
+
+type intRenderer struct{ bits int }
+
+func (intRenderer) Format(_ context.Context, v protoreflect.Value) ([]Screen, error) {
+	return []Screen{{Text: strconv.FormatInt(v.Int(), 10)}}, nil
+}
+
+func (r intRenderer) Parse(_ context.Context, screens []Screen) (protoreflect.Value, error) {
+	text, err := singleScreen(screens)
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+	i, err := strconv.ParseInt(text, 10, r.bits)
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+	if strconv.FormatInt(i, 10) != text {
+		return protoreflect.Value{}, fmt.Errorf("non-canonical integer %q", text)
+	}
+	if r.bits == 32 {
+		return protoreflect.ValueOfInt32(int32(i)), nil
+	}
+	return protoreflect.ValueOfInt64(i), nil
+}
+
+type uintRenderer struct{ bits int }
+
+func (uintRenderer) Format(_ context.Context, v protoreflect.Value) ([]Screen, error) {
+	return []Screen{{Text: strconv.FormatUint(v.Uint(), 10)}}, nil
+}
+
+func (r uintRenderer) Parse(_ context.Context, screens []Screen) (protoreflect.Value, error) {
+	text, err := singleScreen(screens)
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+	u, err := strconv.ParseUint(text, 10, r.bits)
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+	if strconv.FormatUint(u, 10) != text {
+		return protoreflect.Value{}, fmt.Errorf("non-canonical integer %q", text)
+	}
+	if r.bits == 32 {
+		return protoreflect.ValueOfUint32(uint32(u)), nil
+	}
+	return protoreflect.ValueOfUint64(u), nil
+}
+
+type floatRenderer struct{ bits int }
+
+func (r floatRenderer) Format(_ context.Context, v protoreflect.Value) ([]Screen, error) {
+	return []Screen{{Text: strconv.FormatFloat(v.Float(), 'g', -1, r.bits)}}, nil
+}
+
+func (r floatRenderer) Parse(_ context.Context, screens []Screen) (protoreflect.Value, error) {
+	text, err := singleScreen(screens)
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+	f, err := strconv.ParseFloat(text, r.bits)
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+	if strconv.FormatFloat(f, 'g', -1, r.bits) != text {
+		return protoreflect.Value{}, fmt.Errorf("non-canonical number %q", text)
+	}
+	if r.bits == 32 {
+		return protoreflect.ValueOfFloat32(float32(f)), nil
+	}
+	return protoreflect.ValueOfFloat64(f), nil
+}
+
+// enumRenderer renders enum values by name, or by number if they are unknown.
+type enumRenderer struct {
+	ed protoreflect.EnumDescriptor
+}
+
+func (r enumRenderer) Format(_ context.Context, v protoreflect.Value) ([]Screen, error) {
+	if ev := r.ed.Values().ByNumber(v.Enum()); ev != nil {
+		return []Screen{{Text: string(ev.Name())}}, nil
+	}
+	return []Screen{{Text: strconv.FormatInt(int64(v.Enum()), 10)}}, nil
+}
+
+func (r enumRenderer) Parse(_ context.Context, screens []Screen) (protoreflect.Value, error) {
+	text, err := singleScreen(screens)
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+	if ev := r.ed.Values().ByName(protoreflect.Name(text)); ev != nil {
+		return protoreflect.ValueOfEnum(ev.Number()), nil
+	}
+	n, err := strconv.ParseInt(text, 10, 32)
+	if err != nil {
+		return protoreflect.Value{}, fmt.Errorf("invalid %s value %q", r.ed.FullName(), text)
+	}
+	if r.ed.Values().ByNumber(protoreflect.EnumNumber(n)) != nil {
+		// known values are rendered by name
+		return protoreflect.Value{}, fmt.Errorf("invalid %s value %q", r.ed.FullName(), text)
+	}
+	return protoreflect.ValueOfEnum(protoreflect.EnumNumber(n)), nil
+}
@@ -0,0 +1,135 @@
+// Package textual implements the rendering of transactions as screens of
+// human-readable text for SIGN_MODE_TEXTUAL, for instance for signing them
+// with hardware wallets. The rendering is reversible, each value renderer
+// being able to parse the screens it renders, so that signing the screens is
+// equivalent to signing the transaction.
+package textual
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// Screen is the unit of text displayed by a signing device, for instance a
+// hardware wallet, to its user.
+type Screen struct {
+	// Text is the text of the screen.
+	Text string `json:"text,omitempty"`
+
+	// Indent is the indentation level of the screen, 0 for screens at the
+	// top level and n+1 for the screens of values nested in screens at level n.
+	Indent int `json:"indent,omitempty"`
+
+	// Expert specifies whether the screen is only displayed to users who have
+	// enabled the expert mode of their device.
+	Expert bool `json:"expert,omitempty"`
+}
+
+// ValueRenderer renders values of a given protobuf type as screens and parses
+// them back from their screens. Rendering must be injective, i.e. Parse must
+// return the formatted value for the output of Format, so that signing the
+// screens is equivalent to signing the value.
+type ValueRenderer interface {
+	// Format renders the value as screens. The first screen is at indent 0
+	// and the following ones, if any, at indent 1 or more.
+	Format(ctx context.Context, v protoreflect.Value) ([]Screen, error)
+
+	// Parse is the inverse of Format.
+	Parse(ctx context.Context, screens []Screen) (protoreflect.Value, error)
+}
+
+// CoinMetadataQueryFn returns the bank metadata of the denom whose base denom
+// or display denom is the provided denom, or nil if there is no such metadata.
+// It is used for rendering coins in their display denom.
+type CoinMetadataQueryFn func(ctx context.Context, denom string) (*banktypes.Metadata, error)
+
+// Textual renders transactions as screens for SIGN_MODE_TEXTUAL.
+type Textual struct {
+	coinMetadataQuerier CoinMetadataQueryFn
+	registry            *fileRegistry
+}
+
+// NewTextual returns a new Textual which uses the provided function for
+// querying the bank metadata of the denoms of the coins it renders.
+func NewTextual(q CoinMetadataQueryFn) Textual {
+	if q == nil {
+		q = func(context.Context, string) (*banktypes.Metadata, error) { return nil, nil }
+	}
+
+	return Textual{coinMetadataQuerier: q, registry: newFileRegistry()}
+}
+
+// EncodeScreens returns the deterministic encoding of the screens which is
+// signed in SIGN_MODE_TEXTUAL, a JSON array of screen objects.
+func EncodeScreens(screens []Screen) ([]byte, error) {
+	return json.Marshal(screens)
+}
+
+// GetValueRenderer returns the value renderer of the values of the field.
+// Lists and maps are not supported, GetFieldValueRenderer handles lists.
+func (t Textual) GetValueRenderer(fd protoreflect.FieldDescriptor) (ValueRenderer, error) {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return t.GetMessageValueRenderer(fd.Message())
+
+	case protoreflect.StringKind:
+		if isAddress(fd) {
+			return addressRenderer{}, nil
+		}
+		return stringRenderer{}, nil
+
+	case protoreflect.BytesKind:
+		return bytesRenderer{}, nil
+
+	case protoreflect.BoolKind:
+		return boolRenderer{}, nil
+
+	case protoreflect.EnumKind:
+		return enumRenderer{ed: fd.Enum()}, nil
+
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return intRenderer{bits: bitSize(fd.Kind())}, nil
+
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return uintRenderer{bits: bitSize(fd.Kind())}, nil
+
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return floatRenderer{bits: bitSize(fd.Kind())}, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported field %s of kind %s", fd.FullName(), fd.Kind())
+	}
+}
+
+// GetMessageValueRenderer returns the value renderer of the messages of the
+// provided type.
+func (t Textual) GetMessageValueRenderer(md protoreflect.MessageDescriptor) (ValueRenderer, error) {
+	switch md.FullName() {
+	case timestampName:
+		return timestampRenderer{md: md}, nil
+	case durationName:
+		return durationRenderer{md: md}, nil
+	case coinName:
+		return coinRenderer{t: t, md: md}, nil
+	case anyName:
+		return anyRenderer{t: t, md: md}, nil
+	default:
+		return messageRenderer{t: t, md: md}, nil
+	}
+}
+
+func bitSize(kind protoreflect.Kind) int {
+	switch kind {
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.FloatKind:
+		return 32
+	default:
+		return 64
+	}
+}
@@ -0,0 +1,98 @@
+package textual
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+const (
+	timestampName protoreflect.FullName = "google.protobuf.Timestamp"
+	durationName  protoreflect.FullName = "google.protobuf.Duration"
+)
+
+// timestampRenderer renders timestamps in RFC 3339 format in UTC, e.g.
+// "2006-01-02T15:04:05.999999999Z".
+type timestampRenderer struct {
+	md protoreflect.MessageDescriptor
+}
+
+func (r timestampRenderer) Format(_ context.Context, v protoreflect.Value) ([]Screen, error) {
+	seconds, nanos := secondsAndNanos(r.md, v.Message())
+	if nanos < 0 || nanos >= int64(time.Second) {
+		return nil, fmt.Errorf("invalid timestamp nanos %d", nanos)
+	}
+
+	t := time.Unix(seconds, nanos).UTC()
+	return []Screen{{Text: t.Format(time.RFC3339Nano)}}, nil
+}
+
+func (r timestampRenderer) Parse(_ context.Context, screens []Screen) (protoreflect.Value, error) {
+	text, err := singleScreen(screens)
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, text)
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+	if t.UTC().Format(time.RFC3339Nano) != text {
+		return protoreflect.Value{}, fmt.Errorf("non-canonical timestamp %q", text)
+	}
+
+	return newSecondsAndNanos(r.md, t.Unix(), int64(t.Nanosecond())), nil
+}
+
+// durationRenderer renders durations like time.Duration.String, e.g.
+// "1h2m3.5s".
+type durationRenderer struct {
+	md protoreflect.MessageDescriptor
+}
+
+func (r durationRenderer) Format(_ context.Context, v protoreflect.Value) ([]Screen, error) {
+	seconds, nanos := secondsAndNanos(r.md, v.Message())
+	d := time.Duration(seconds)*time.Second + time.Duration(nanos)
+	if d/time.Second != time.Duration(seconds) {
+		return nil, fmt.Errorf("duration of %d seconds is out of range", seconds)
+	}
+
+	return []Screen{{Text: d.String()}}, nil
+}
+
+func (r durationRenderer) Parse(_ context.Context, screens []Screen) (protoreflect.Value, error) {
+	text, err := singleScreen(screens)
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+
+	d, err := time.ParseDuration(text)
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+	if d.String() != text {
+		return protoreflect.Value{}, fmt.Errorf("non-canonical duration %q", text)
+	}
+
+	return newSecondsAndNanos(r.md, int64(d/time.Second), int64(d%time.Second)), nil
+}
+
+func secondsAndNanos(md protoreflect.MessageDescriptor, msg protoreflect.Message) (int64, int64) {
+	seconds := msg.Get(md.Fields().ByName("seconds")).Int()
+	nanos := msg.Get(md.Fields().ByName("nanos")).Int()
+	return seconds, nanos
+}
+
+func newSecondsAndNanos(md protoreflect.MessageDescriptor, seconds, nanos int64) protoreflect.Value {
+	msg := dynamicpb.NewMessage(md)
+	if seconds != 0 {
+		msg.Set(md.Fields().ByName("seconds"), protoreflect.ValueOfInt64(seconds))
+	}
+	if nanos != 0 {
+		msg.Set(md.Fields().ByName("nanos"), protoreflect.ValueOfInt32(int32(nanos)))
+	}
+	return protoreflect.ValueOfMessage(msg)
+}
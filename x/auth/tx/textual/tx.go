@@ -0,0 +1,170 @@
+package textual
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx/textual/internal/textualpb"
+)
+
+// expertFields are the fields of the envelope whose screens are only
+// displayed in expert mode.
+var expertFields = map[protoreflect.Name]bool{
+	"public_key":                    true,
+	"other_signer":                  true,
+	"extension_option":              true,
+	"non_critical_extension_option": true,
+	"hash_of_raw_bytes":             true,
+}
+
+// TxData is the data of a transaction which is rendered in SIGN_MODE_TEXTUAL.
+type TxData struct {
+	Body          *tx.TxBody
+	AuthInfo      *tx.AuthInfo
+	BodyBytes     []byte
+	AuthInfoBytes []byte
+}
+
+// FormatTx renders the transaction for the signer as screens. The fields of
+// the transaction are rendered at indent 0, without a header screen.
+func (t Textual) FormatTx(ctx context.Context, signerData signing.SignerData, data TxData) ([]Screen, error) {
+	envelope, err := newEnvelope(signerData, data)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := t.registry.toDynamic(envelope)
+	if err != nil {
+		return nil, err
+	}
+
+	return envelopeRenderer{t: t, md: msg.Descriptor()}.Format(ctx, protoreflect.ValueOfMessage(msg))
+}
+
+// GetSignBytes returns the SIGN_MODE_TEXTUAL sign bytes of the transaction
+// for the signer, the encoding of its screens.
+func (t Textual) GetSignBytes(ctx context.Context, signerData signing.SignerData, data TxData) ([]byte, error) {
+	screens, err := t.FormatTx(ctx, signerData, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return EncodeScreens(screens)
+}
+
+func newEnvelope(signerData signing.SignerData, data TxData) (*textualpb.Envelope, error) {
+	if data.Body == nil || data.AuthInfo == nil || data.AuthInfo.Fee == nil {
+		return nil, fmt.Errorf("transaction body, auth info and fee are required")
+	}
+
+	var pubKey *codectypes.Any
+	if signerData.PubKey != nil {
+		var err error
+		pubKey, err = codectypes.NewAnyWithValue(signerData.PubKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var otherSigners []*tx.SignerInfo
+	for _, si := range data.AuthInfo.SignerInfos {
+		if pubKey != nil && si.PublicKey != nil && si.PublicKey.TypeUrl == pubKey.TypeUrl && bytes.Equal(si.PublicKey.Value, pubKey.Value) {
+			continue
+		}
+		otherSigners = append(otherSigners, si)
+	}
+
+	hash := sha256.New()
+	hash.Write(data.BodyBytes)
+	hash.Write(data.AuthInfoBytes)
+
+	envelope := &textualpb.Envelope{
+		ChainId:                    signerData.ChainID,
+		AccountNumber:              signerData.AccountNumber,
+		Sequence:                   signerData.Sequence,
+		Address:                    signerData.Address,
+		PublicKey:                  pubKey,
+		Message:                    data.Body.Messages,
+		Memo:                       data.Body.Memo,
+		Fees:                       data.AuthInfo.Fee.Amount,
+		FeePayer:                   data.AuthInfo.Fee.Payer,
+		FeeGranter:                 data.AuthInfo.Fee.Granter,
+		GasLimit:                   data.AuthInfo.Fee.GasLimit,
+		TimeoutHeight:              data.Body.TimeoutHeight,
+		OtherSigner:                otherSigners,
+		ExtensionOption:            data.Body.ExtensionOptions,
+		NonCriticalExtensionOption: data.Body.NonCriticalExtensionOptions,
+		HashOfRawBytes:             hex.EncodeToString(hash.Sum(nil)),
+	}
+
+	if tip := data.AuthInfo.Tip; tip != nil {
+		envelope.Tip = tip.Amount
+		envelope.Tipper = tip.Tipper
+	}
+
+	return envelope, nil
+}
+
+// envelopeRenderer renders the envelope of a transaction like a message but
+// without its header and indentation, and with the screens of its expert
+// fields marked as such.
+type envelopeRenderer struct {
+	t  Textual
+	md protoreflect.MessageDescriptor
+}
+
+func (r envelopeRenderer) Format(ctx context.Context, v protoreflect.Value) ([]Screen, error) {
+	msg := v.Message()
+
+	var screens []Screen
+	for _, fd := range sortedFields(r.md) {
+		if !msg.Has(fd) {
+			continue
+		}
+
+		fieldScreens, err := r.t.formatField(ctx, fd, msg.Get(fd))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", fd.Name(), err)
+		}
+
+		if expertFields[fd.Name()] {
+			for i := range fieldScreens {
+				fieldScreens[i].Expert = true
+			}
+		}
+		screens = append(screens, fieldScreens...)
+	}
+
+	return screens, nil
+}
+
+func (r envelopeRenderer) Parse(ctx context.Context, screens []Screen) (protoreflect.Value, error) {
+	msg, err := messageRenderer{t: r.t, md: r.md}.parseFields(ctx, screens)
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+
+	// the expert flags are signed too, so they must be the rendered ones
+	formatted, err := r.Format(ctx, protoreflect.ValueOfMessage(msg))
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+	if len(formatted) != len(screens) {
+		return protoreflect.Value{}, fmt.Errorf("expected %d screens, got %d", len(formatted), len(screens))
+	}
+	for i, s := range formatted {
+		if screens[i].Expert != s.Expert {
+			return protoreflect.Value{}, fmt.Errorf("screen %q has an invalid expert flag", s.Text)
+		}
+	}
+
+	return protoreflect.ValueOfMessage(msg), nil
+}
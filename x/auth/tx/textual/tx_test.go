@@ -0,0 +1,144 @@
+package textual
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+type txTest struct {
+	Name       string `json:"name"`
+	SignerData struct {
+		Address       string `json:"address"`
+		ChainID       string `json:"chain_id"`
+		AccountNumber uint64 `json:"account_number"`
+		Sequence      uint64 `json:"sequence"`
+		PubKey        string `json:"pub_key"`
+	} `json:"signer_data"`
+	Body     json.RawMessage `json:"body"`
+	AuthInfo json.RawMessage `json:"auth_info"`
+	Metadata *coinMetadata   `json:"metadata"`
+	Screens  []Screen        `json:"screens"`
+	Error    bool            `json:"error"`
+}
+
+func TestTxRenderer(t *testing.T) {
+	bz, err := os.ReadFile("internal/testdata/tx.json")
+	require.NoError(t, err)
+
+	var tests []txTest
+	require.NoError(t, json.Unmarshal(bz, &tests))
+
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	banktypes.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			ctx := context.Background()
+			textual := NewTextual(newMetadataQuerier(tc.Metadata))
+
+			var body tx.TxBody
+			require.NoError(t, cdc.UnmarshalJSON(tc.Body, &body))
+			var authInfo tx.AuthInfo
+			require.NoError(t, cdc.UnmarshalJSON(tc.AuthInfo, &authInfo))
+
+			bodyBz, err := body.Marshal()
+			require.NoError(t, err)
+			authInfoBz, err := authInfo.Marshal()
+			require.NoError(t, err)
+
+			pubKeyBz, err := hex.DecodeString(tc.SignerData.PubKey)
+			require.NoError(t, err)
+
+			signerData := signing.SignerData{
+				Address:       tc.SignerData.Address,
+				ChainID:       tc.SignerData.ChainID,
+				AccountNumber: tc.SignerData.AccountNumber,
+				Sequence:      tc.SignerData.Sequence,
+				PubKey:        &secp256k1.PubKey{Key: pubKeyBz},
+			}
+			data := TxData{Body: &body, AuthInfo: &authInfo, BodyBytes: bodyBz, AuthInfoBytes: authInfoBz}
+
+			screens, err := textual.FormatTx(ctx, signerData, data)
+			if tc.Error {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.Screens, screens)
+
+			signBytes, err := textual.GetSignBytes(ctx, signerData, data)
+			require.NoError(t, err)
+			expected, err := EncodeScreens(tc.Screens)
+			require.NoError(t, err)
+			require.Equal(t, expected, signBytes)
+
+			// the screens must be parsed back to the rendered envelope
+			envelope, err := newEnvelope(signerData, data)
+			require.NoError(t, err)
+			msg, err := textual.registry.toDynamic(envelope)
+			require.NoError(t, err)
+
+			r := envelopeRenderer{t: textual, md: msg.Descriptor()}
+			v, err := r.Parse(ctx, screens)
+			require.NoError(t, err)
+			require.True(t, proto.Equal(msg, v.Message().Interface()), "parsed %v, expected %v", v.Message(), msg)
+
+			// screens which differ in any way must not be parsed to the
+			// same envelope
+			for i := range screens {
+				modified := append([]Screen(nil), screens...)
+				modified[i].Expert = !modified[i].Expert
+				_, err := r.Parse(ctx, modified)
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestFormatValueRoundTrip(t *testing.T) {
+	textual := NewTextual(nil)
+	ctx := context.Background()
+
+	msg, err := textual.registry.toDynamic(&banktypes.MsgMultiSend{
+		Inputs: []banktypes.Input{{Address: "cosmos18427pnwf35jskwz5pzmrxquaaz4rdfpe0t4hm9", Coins: nil}},
+	})
+	require.NoError(t, err)
+
+	vr, err := textual.GetMessageValueRenderer(msg.Descriptor())
+	require.NoError(t, err)
+
+	screens, err := vr.Format(ctx, protoreflect.ValueOfMessage(msg))
+	require.NoError(t, err)
+	require.Equal(t, []Screen{
+		{Text: "MsgMultiSend object"},
+		{Text: "Inputs: 1 Input", Indent: 1},
+		{Text: "Inputs (1/1): Input object", Indent: 2},
+		{Text: "Address: cosmos18427pnwf35jskwz5pzmrxquaaz4rdfpe0t4hm9", Indent: 3},
+		{Text: "End of Inputs", Indent: 1},
+	}, screens)
+
+	v, err := vr.Parse(ctx, screens)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(msg, v.Message().Interface()))
+
+	_, err = vr.Parse(ctx, screens[:len(screens)-1])
+	require.Error(t, err)
+}
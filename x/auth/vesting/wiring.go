@@ -0,0 +1,23 @@
+package vesting
+
+import (
+	modulev1 "github.com/cosmos/cosmos-sdk/api/cosmos/vesting/module/v1"
+	"github.com/cosmos/cosmos-sdk/appconfig"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+)
+
+func init() {
+	appconfig.Register(&modulev1.Module{},
+		appconfig.Provide(provideModuleBasic, provideModule),
+	)
+}
+
+func provideModuleBasic() runtime.AppModuleBasicWrapper {
+	return runtime.WrapAppModuleBasic(AppModuleBasic{})
+}
+
+func provideModule(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper) runtime.AppModuleWrapper {
+	return runtime.WrapAppModule(NewAppModule(ak, bk))
+}
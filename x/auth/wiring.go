@@ -0,0 +1,114 @@
+package auth
+
+import (
+	"github.com/spf13/cast"
+
+	modulev1 "github.com/cosmos/cosmos-sdk/api/cosmos/auth/module/v1"
+	"github.com/cosmos/cosmos-sdk/appconfig"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/container"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/middleware"
+	"github.com/cosmos/cosmos-sdk/x/auth/simulation"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func init() {
+	appconfig.Register(&modulev1.Module{},
+		appconfig.Provide(provideModuleBasic, provideModule, provideTxConfig, provideTxHandler),
+	)
+}
+
+func provideModuleBasic() runtime.AppModuleBasicWrapper {
+	return runtime.WrapAppModuleBasic(AppModuleBasic{})
+}
+
+type authInputs struct {
+	container.In
+
+	Config   *modulev1.Module
+	Key      *storetypes.KVStoreKey
+	Cdc      codec.Codec
+	Subspace paramstypes.Subspace
+}
+
+type authOutputs struct {
+	container.Out
+
+	AccountKeeper keeper.AccountKeeper
+	Module        runtime.AppModuleWrapper
+}
+
+func provideModule(in authInputs) authOutputs {
+	maccPerms := map[string][]string{}
+	for _, permission := range in.Config.ModuleAccountPermissions {
+		maccPerms[permission.Account] = permission.Permissions
+	}
+
+	bech32Prefix := in.Config.Bech32Prefix
+	if bech32Prefix == "" {
+		bech32Prefix = sdk.Bech32MainPrefix
+	}
+
+	k := keeper.NewAccountKeeper(in.Cdc, in.Key, in.Subspace, types.ProtoBaseAccount, maccPerms, bech32Prefix)
+	m := NewAppModule(in.Cdc, k, simulation.RandomGenesisAccounts)
+
+	return authOutputs{AccountKeeper: k, Module: runtime.WrapAppModule(m)}
+}
+
+func provideTxConfig(cdc codec.ProtoCodecMarshaler) client.TxConfig {
+	return tx.NewTxConfig(cdc, tx.DefaultSignModes)
+}
+
+type txHandlerInputs struct {
+	container.In
+
+	AccountKeeper    keeper.AccountKeeper
+	BankKeeper       bankkeeper.Keeper
+	FeegrantKeeper   middleware.FeegrantKeeper `optional:"true"`
+	TxConfig         client.TxConfig
+	MsgServiceRouter *middleware.MsgServiceRouter
+	LegacyRouter     sdk.Router
+	AppOptions       servertypes.AppOptions `optional:"true"`
+}
+
+// provideTxHandler provides a BaseAppOption which sets the default tx handler
+// once the BaseApp has been created.
+func provideTxHandler(in txHandlerInputs) runtime.BaseAppOption {
+	indexEvents := map[string]struct{}{}
+	if in.AppOptions != nil {
+		for _, e := range cast.ToStringSlice(in.AppOptions.Get(server.FlagIndexEvents)) {
+			indexEvents[e] = struct{}{}
+		}
+	}
+
+	return func(app *baseapp.BaseApp) {
+		txHandler, err := middleware.NewDefaultTxHandler(middleware.TxHandlerOptions{
+			Debug:            app.Trace(),
+			IndexEvents:      indexEvents,
+			LegacyRouter:     in.LegacyRouter,
+			MsgServiceRouter: in.MsgServiceRouter,
+			AccountKeeper:    in.AccountKeeper,
+			BankKeeper:       in.BankKeeper,
+			FeegrantKeeper:   in.FeegrantKeeper,
+			SignModeHandler:  in.TxConfig.SignModeHandler(),
+			SigGasConsumer:   middleware.DefaultSigVerificationGasConsumer,
+			TxDecoder:        in.TxConfig.TxDecoder(),
+		})
+		if err != nil {
+			panic(err)
+		}
+
+		app.SetTxHandler(txHandler)
+	}
+}
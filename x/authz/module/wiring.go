@@ -0,0 +1,50 @@
+package authz
+
+import (
+	modulev1 "github.com/cosmos/cosmos-sdk/api/cosmos/authz/module/v1"
+	"github.com/cosmos/cosmos-sdk/appconfig"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/container"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/middleware"
+	"github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+)
+
+func init() {
+	appconfig.Register(&modulev1.Module{},
+		appconfig.Provide(provideModuleBasic, provideModule),
+	)
+}
+
+func provideModuleBasic() runtime.AppModuleBasicWrapper {
+	return runtime.WrapAppModuleBasic(AppModuleBasic{})
+}
+
+type authzInputs struct {
+	container.In
+
+	Key              *storetypes.KVStoreKey
+	Cdc              codec.Codec
+	Registry         cdctypes.InterfaceRegistry
+	MsgServiceRouter *middleware.MsgServiceRouter
+	AccountKeeper    authkeeper.AccountKeeper
+	BankKeeper       bankkeeper.Keeper
+}
+
+type authzOutputs struct {
+	container.Out
+
+	AuthzKeeper keeper.Keeper
+	Module      runtime.AppModuleWrapper
+}
+
+func provideModule(in authzInputs) authzOutputs {
+	k := keeper.NewKeeper(in.Key, in.Cdc, in.MsgServiceRouter, in.AccountKeeper)
+	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper, in.Registry)
+
+	return authzOutputs{AuthzKeeper: k, Module: runtime.WrapAppModule(m)}
+}
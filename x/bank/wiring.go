@@ -0,0 +1,61 @@
+package bank
+
+import (
+	modulev1 "github.com/cosmos/cosmos-sdk/api/cosmos/bank/module/v1"
+	"github.com/cosmos/cosmos-sdk/appconfig"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/container"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func init() {
+	appconfig.Register(&modulev1.Module{},
+		appconfig.Provide(provideModuleBasic, provideModule),
+	)
+}
+
+func provideModuleBasic() runtime.AppModuleBasicWrapper {
+	return runtime.WrapAppModuleBasic(AppModuleBasic{})
+}
+
+type bankInputs struct {
+	container.In
+
+	Config        *modulev1.Module
+	Key           *storetypes.KVStoreKey
+	Cdc           codec.Codec
+	Subspace      paramstypes.Subspace
+	AccountKeeper authkeeper.AccountKeeper
+}
+
+type bankOutputs struct {
+	container.Out
+
+	BankKeeper keeper.Keeper
+	Module     runtime.AppModuleWrapper
+}
+
+func provideModule(in bankInputs) bankOutputs {
+	// by default all the module accounts configured in the auth module are
+	// blocked from receiving funds
+	blockedAddresses := make(map[string]bool)
+	if len(in.Config.BlockedModuleAccountsOverride) != 0 {
+		for _, moduleName := range in.Config.BlockedModuleAccountsOverride {
+			blockedAddresses[authtypes.NewModuleAddress(moduleName).String()] = true
+		}
+	} else {
+		for _, permission := range in.AccountKeeper.GetModulePermissions() {
+			blockedAddresses[permission.GetAddress().String()] = true
+		}
+	}
+
+	k := keeper.NewBaseKeeper(in.Cdc, in.Key, in.AccountKeeper, in.Subspace, blockedAddresses)
+	m := NewAppModule(in.Cdc, k, in.AccountKeeper)
+
+	return bankOutputs{BankKeeper: k, Module: runtime.WrapAppModule(m)}
+}
@@ -0,0 +1,50 @@
+package capability
+
+import (
+	modulev1 "github.com/cosmos/cosmos-sdk/api/cosmos/capability/module/v1"
+	"github.com/cosmos/cosmos-sdk/appconfig"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/container"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/x/capability/keeper"
+)
+
+func init() {
+	appconfig.Register(&modulev1.Module{},
+		appconfig.Provide(provideModuleBasic, provideModule),
+	)
+}
+
+func provideModuleBasic() runtime.AppModuleBasicWrapper {
+	return runtime.WrapAppModuleBasic(AppModuleBasic{})
+}
+
+type capabilityInputs struct {
+	container.In
+
+	Config *modulev1.Module
+	Key    *storetypes.KVStoreKey
+	MemKey *storetypes.MemoryStoreKey
+	Cdc    codec.Codec
+}
+
+type capabilityOutputs struct {
+	container.Out
+
+	CapabilityKeeper *keeper.Keeper
+	Module           runtime.AppModuleWrapper
+}
+
+func provideModule(in capabilityInputs) capabilityOutputs {
+	k := keeper.NewKeeper(in.Cdc, in.Key, in.MemKey)
+
+	// NOTE: scoped keepers can't be created once the keeper has been sealed
+	if in.Config.SealKeeper {
+		k.Seal()
+	}
+
+	m := NewAppModule(in.Cdc, *k)
+
+	return capabilityOutputs{CapabilityKeeper: k, Module: runtime.WrapAppModule(m)}
+}
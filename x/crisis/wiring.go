@@ -0,0 +1,69 @@
+package crisis
+
+import (
+	"github.com/spf13/cast"
+
+	modulev1 "github.com/cosmos/cosmos-sdk/api/cosmos/crisis/module/v1"
+	"github.com/cosmos/cosmos-sdk/appconfig"
+	"github.com/cosmos/cosmos-sdk/container"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/crisis/keeper"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func init() {
+	appconfig.Register(&modulev1.Module{},
+		appconfig.Provide(provideModuleBasic, provideModule),
+	)
+}
+
+func provideModuleBasic() runtime.AppModuleBasicWrapper {
+	return runtime.WrapAppModuleBasic(AppModuleBasic{})
+}
+
+type crisisInputs struct {
+	container.In
+
+	Config     *modulev1.Module
+	Subspace   paramstypes.Subspace
+	BankKeeper bankkeeper.Keeper
+	AppOptions servertypes.AppOptions `optional:"true"`
+}
+
+type crisisOutputs struct {
+	container.Out
+
+	CrisisKeeper      *keeper.Keeper
+	InvariantRegistry sdk.InvariantRegistry
+	Module            runtime.AppModuleWrapper
+}
+
+func provideModule(in crisisInputs) crisisOutputs {
+	var (
+		invCheckPeriod        uint
+		skipGenesisInvariants bool
+	)
+	if in.AppOptions != nil {
+		invCheckPeriod = cast.ToUint(in.AppOptions.Get(server.FlagInvCheckPeriod))
+		skipGenesisInvariants = cast.ToBool(in.AppOptions.Get(FlagSkipGenesisInvariants))
+	}
+
+	feeCollectorName := in.Config.FeeCollectorName
+	if feeCollectorName == "" {
+		feeCollectorName = authtypes.FeeCollectorName
+	}
+
+	k := keeper.NewKeeper(in.Subspace, invCheckPeriod, in.BankKeeper, feeCollectorName)
+	m := NewAppModule(&k, skipGenesisInvariants)
+
+	return crisisOutputs{
+		CrisisKeeper:      &k,
+		InvariantRegistry: &k,
+		Module:            runtime.WrapAppModule(m),
+	}
+}
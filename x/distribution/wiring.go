@@ -0,0 +1,70 @@
+package distribution
+
+import (
+	modulev1 "github.com/cosmos/cosmos-sdk/api/cosmos/distribution/module/v1"
+	"github.com/cosmos/cosmos-sdk/appconfig"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/container"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func init() {
+	appconfig.Register(&modulev1.Module{},
+		appconfig.Provide(provideModuleBasic, provideModule),
+	)
+}
+
+func provideModuleBasic() runtime.AppModuleBasicWrapper {
+	return runtime.WrapAppModuleBasic(AppModuleBasic{})
+}
+
+type distrInputs struct {
+	container.In
+
+	Config        *modulev1.Module
+	Key           *storetypes.KVStoreKey
+	Cdc           codec.Codec
+	Subspace      paramstypes.Subspace
+	AccountKeeper authkeeper.AccountKeeper
+	BankKeeper    bankkeeper.Keeper
+	StakingKeeper *stakingkeeper.Keeper
+}
+
+type distrOutputs struct {
+	container.Out
+
+	DistrKeeper  keeper.Keeper
+	Module       runtime.AppModuleWrapper
+	StakingHooks stakingtypes.StakingHooksWrapper
+	GovHandler   govv1beta1.HandlerRoute
+}
+
+func provideModule(in distrInputs) distrOutputs {
+	feeCollectorName := in.Config.FeeCollectorName
+	if feeCollectorName == "" {
+		feeCollectorName = authtypes.FeeCollectorName
+	}
+
+	k := keeper.NewKeeper(
+		in.Cdc, in.Key, in.Subspace, in.AccountKeeper, in.BankKeeper,
+		in.StakingKeeper, feeCollectorName,
+	)
+	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper, *in.StakingKeeper)
+
+	return distrOutputs{
+		DistrKeeper:  k,
+		Module:       runtime.WrapAppModule(m),
+		StakingHooks: stakingtypes.StakingHooksWrapper{StakingHooks: k.Hooks()},
+		GovHandler:   govv1beta1.NewHandlerRoute(types.RouterKey, NewCommunityPoolSpendProposalHandler(k)),
+	}
+}
@@ -0,0 +1,46 @@
+package evidence
+
+import (
+	modulev1 "github.com/cosmos/cosmos-sdk/api/cosmos/evidence/module/v1"
+	"github.com/cosmos/cosmos-sdk/appconfig"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/container"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence/keeper"
+	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+)
+
+func init() {
+	appconfig.Register(&modulev1.Module{},
+		appconfig.Provide(provideModuleBasic, provideModule),
+	)
+}
+
+func provideModuleBasic() runtime.AppModuleBasicWrapper {
+	return runtime.WrapAppModuleBasic(AppModuleBasic{})
+}
+
+type evidenceInputs struct {
+	container.In
+
+	Key            *storetypes.KVStoreKey
+	Cdc            codec.Codec
+	StakingKeeper  *stakingkeeper.Keeper
+	SlashingKeeper slashingkeeper.Keeper
+}
+
+type evidenceOutputs struct {
+	container.Out
+
+	EvidenceKeeper keeper.Keeper
+	Module         runtime.AppModuleWrapper
+}
+
+func provideModule(in evidenceInputs) evidenceOutputs {
+	k := *keeper.NewKeeper(in.Cdc, in.Key, in.StakingKeeper, in.SlashingKeeper)
+	m := NewAppModule(k)
+
+	return evidenceOutputs{EvidenceKeeper: k, Module: runtime.WrapAppModule(m)}
+}
@@ -0,0 +1,56 @@
+package module
+
+import (
+	modulev1 "github.com/cosmos/cosmos-sdk/api/cosmos/feegrant/module/v1"
+	"github.com/cosmos/cosmos-sdk/appconfig"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/container"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/middleware"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
+)
+
+func init() {
+	appconfig.Register(&modulev1.Module{},
+		appconfig.Provide(provideModuleBasic, provideModule),
+	)
+}
+
+func provideModuleBasic() runtime.AppModuleBasicWrapper {
+	return runtime.WrapAppModuleBasic(AppModuleBasic{})
+}
+
+type feegrantInputs struct {
+	container.In
+
+	Key           *storetypes.KVStoreKey
+	Cdc           codec.Codec
+	Registry      cdctypes.InterfaceRegistry
+	AccountKeeper authkeeper.AccountKeeper
+	BankKeeper    bankkeeper.Keeper
+}
+
+type feegrantOutputs struct {
+	container.Out
+
+	FeegrantKeeper keeper.Keeper
+	// TxFeegrantKeeper enables fee grants in the tx handler provided by the
+	// auth module.
+	TxFeegrantKeeper middleware.FeegrantKeeper
+	Module           runtime.AppModuleWrapper
+}
+
+func provideModule(in feegrantInputs) feegrantOutputs {
+	k := keeper.NewKeeper(in.Cdc, in.Key, in.AccountKeeper)
+	m := NewAppModule(in.Cdc, in.AccountKeeper, in.BankKeeper, k, in.Registry)
+
+	return feegrantOutputs{
+		FeegrantKeeper:   k,
+		TxFeegrantKeeper: k,
+		Module:           runtime.WrapAppModule(m),
+	}
+}
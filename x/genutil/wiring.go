@@ -0,0 +1,35 @@
+package genutil
+
+import (
+	modulev1 "github.com/cosmos/cosmos-sdk/api/cosmos/genutil/module/v1"
+	"github.com/cosmos/cosmos-sdk/appconfig"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/container"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+)
+
+func init() {
+	appconfig.Register(&modulev1.Module{},
+		appconfig.Provide(provideModuleBasic, provideModule),
+	)
+}
+
+func provideModuleBasic() runtime.AppModuleBasicWrapper {
+	return runtime.WrapAppModuleBasic(AppModuleBasic{})
+}
+
+type genutilInputs struct {
+	container.In
+
+	AccountKeeper authkeeper.AccountKeeper
+	StakingKeeper *stakingkeeper.Keeper
+	App           *runtime.App
+	TxConfig      client.TxConfig
+}
+
+func provideModule(in genutilInputs) runtime.AppModuleWrapper {
+	m := NewAppModule(in.AccountKeeper, in.StakingKeeper, in.App.DeliverTxFunc(), in.TxConfig)
+	return runtime.WrapAppModule(m)
+}
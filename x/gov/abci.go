@@ -36,7 +36,7 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 		logger.Info(
 			"proposal did not meet minimum deposit; deleted",
 			"proposal", proposal.Id,
-			"min_deposit", sdk.NewCoins(keeper.GetDepositParams(ctx).MinDeposit...).String(),
+			"min_deposit", sdk.NewCoins(keeper.GetDepositParams(ctx).MinDepositFor(proposal.Expedited)...).String(),
 			"total_deposit", sdk.NewCoins(proposal.TotalDeposit...).String(),
 		)
 
@@ -49,13 +49,20 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 
 		passes, burnDeposits, tallyResults := keeper.Tally(ctx, proposal)
 
-		if burnDeposits {
-			keeper.DeleteAndBurnDeposits(ctx, proposal.Id)
-		} else {
-			keeper.RefundAndDeleteDeposits(ctx, proposal.Id)
+		// An expedited proposal which fails is converted to a regular one
+		// below, so its deposits are kept until its regular voting period ends.
+		if !proposal.Expedited || passes {
+			if burnDeposits {
+				keeper.DeleteAndBurnDeposits(ctx, proposal.Id)
+			} else {
+				keeper.RefundAndDeleteDeposits(ctx, proposal.Id)
+			}
 		}
 
-		if passes {
+		keeper.RemoveFromActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
+
+		switch {
+		case passes:
 			var (
 				idx int
 				msg sdk.Msg
@@ -97,19 +104,36 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 				tagValue = types.AttributeValueProposalFailed
 				logMsg = fmt.Sprintf("passed, but msg %d (%s) failed on execution: %s", idx, sdk.MsgTypeURL(msg), err)
 			}
-		} else {
+		case proposal.Expedited:
+			// The expedited proposal did not pass within the expedited voting
+			// period: convert it to a regular proposal, keeping its votes and
+			// voting start time, to be tallied again at the end of the
+			// regular voting period.
+			proposal.Expedited = false
+			endTime := proposal.VotingStartTime.Add(keeper.ProposalVotingPeriod(ctx, proposal))
+			proposal.VotingEndTime = &endTime
+			keeper.InsertActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
+
+			tagValue = types.AttributeValueExpeditedProposalRejected
+			logMsg = "expedited proposal converted to regular"
+		default:
 			proposal.Status = v1.StatusRejected
 			tagValue = types.AttributeValueProposalRejected
 			logMsg = "rejected"
 		}
 
-		proposal.FinalTallyResult = &tallyResults
+		// a converted expedited proposal is still in its voting period
+		votingPeriodEnded := proposal.Status != v1.StatusVotingPeriod
+		if votingPeriodEnded {
+			proposal.FinalTallyResult = &tallyResults
+		}
 
 		keeper.SetProposal(ctx, proposal)
-		keeper.RemoveFromActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
 
 		// when proposal become active
-		keeper.AfterProposalVotingPeriodEnded(ctx, proposal.Id)
+		if votingPeriodEnded {
+			keeper.AfterProposalVotingPeriodEnded(ctx, proposal.Id)
+		}
 
 		logger.Info(
 			"proposal tallied",
@@ -34,6 +34,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryVotes(),
 		GetCmdQueryParam(),
 		GetCmdQueryParams(),
+		GetCmdQueryMessageBasedParams(),
 		GetCmdQueryProposer(),
 		GetCmdQueryDeposit(),
 		GetCmdQueryDeposits(),
@@ -629,6 +630,43 @@ $ %s query gov param deposit
 	return cmd
 }
 
+// GetCmdQueryMessageBasedParams implements the query message based params command.
+func GetCmdQueryMessageBasedParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "message-based-params",
+		Short: "Query the governance parameters overriding the global ones for given message types",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the voting and tally parameters overriding the global ones for the
+proposals containing given message types.
+
+Example:
+$ %s query gov message-based-params
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MessageBasedParams(cmd.Context(), &v1.QueryMessageBasedParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryProposer implements the query proposer command.
 func GetCmdQueryProposer() *cobra.Command {
 	cmd := &cobra.Command{
@@ -86,7 +86,9 @@ func NewCmdSubmitProposal() *cobra.Command {
 		Args:  cobra.ExactArgs(1),
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Submit a proposal along with some messages and metadata.
-Messages, metadata and deposit are defined in a JSON file.
+Messages, metadata and deposit are defined in a JSON file. An expedited
+proposal has a higher minimum deposit and threshold, and a shorter voting
+period, after which it is converted to a regular proposal if it did not pass.
 
 Example:
 $ %s tx gov submit-proposal path/to/proposal.json
@@ -104,7 +106,8 @@ Where proposal.json contains:
     }
   ],
   "metadata: "4pIMOgIGx1vZGU=", // base64-encoded metadata
-  "deposit": "10stake"
+  "deposit": "10stake",
+  "expedited": false // optional
 }
 `,
 				version.AppName,
@@ -116,12 +119,12 @@ Where proposal.json contains:
 				return err
 			}
 
-			msgs, metadata, deposit, err := parseSubmitProposal(clientCtx.Codec, args[0])
+			proposal, msgs, deposit, err := parseSubmitProposal(clientCtx.Codec, args[0])
 			if err != nil {
 				return err
 			}
 
-			msg, err := v1.NewMsgSubmitProposal(msgs, deposit, clientCtx.GetFromAddress().String(), metadata)
+			msg, err := v1.NewMsgSubmitProposal(msgs, deposit, clientCtx.GetFromAddress().String(), proposal.Metadata, proposal.Expedited)
 			if err != nil {
 				return fmt.Errorf("invalid message: %w", err)
 			}
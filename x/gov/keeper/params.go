@@ -26,6 +26,14 @@ func (keeper Keeper) GetTallyParams(ctx sdk.Context) v1.TallyParams {
 	return tallyParams
 }
 
+// GetMessageBasedParams returns the current MessageBasedParams from the global
+// param store
+func (keeper Keeper) GetMessageBasedParams(ctx sdk.Context) []v1.MessageBasedParams {
+	var params []v1.MessageBasedParams
+	keeper.paramSpace.GetIfExists(ctx, v1.ParamStoreKeyMessageBasedParams, &params)
+	return params
+}
+
 // SetDepositParams sets DepositParams to the global param store
 func (keeper Keeper) SetDepositParams(ctx sdk.Context, depositParams v1.DepositParams) {
 	keeper.paramSpace.Set(ctx, v1.ParamStoreKeyDepositParams, &depositParams)
@@ -40,3 +48,8 @@ func (keeper Keeper) SetVotingParams(ctx sdk.Context, votingParams v1.VotingPara
 func (keeper Keeper) SetTallyParams(ctx sdk.Context, tallyParams v1.TallyParams) {
 	keeper.paramSpace.Set(ctx, v1.ParamStoreKeyTallyParams, &tallyParams)
 }
+
+// SetMessageBasedParams sets MessageBasedParams to the global param store
+func (keeper Keeper) SetMessageBasedParams(ctx sdk.Context, params []v1.MessageBasedParams) {
+	keeper.paramSpace.Set(ctx, v1.ParamStoreKeyMessageBasedParams, params)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -11,7 +12,7 @@ import (
 )
 
 // SubmitProposal create new proposal given an array of messages
-func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadata string) (v1.Proposal, error) {
+func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadata string, expedited bool) (v1.Proposal, error) {
 	err := keeper.assertMetadataLength(metadata)
 	if err != nil {
 		return v1.Proposal{}, err
@@ -72,6 +73,8 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadat
 	if err != nil {
 		return v1.Proposal{}, err
 	}
+	proposal.MessageBasedParams = keeper.strictestMessageBasedParams(ctx, messages)
+	proposal.Expedited = expedited
 
 	keeper.SetProposal(ctx, proposal)
 	keeper.InsertInactiveProposalQueue(ctx, proposalID, *proposal.DepositEndTime)
@@ -91,6 +94,41 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadat
 	return proposal, nil
 }
 
+// strictestMessageBasedParams returns the strictest MessageBasedParams of the
+// messages, or nil if one of the messages has no MessageBasedParams and the
+// global params are stricter.
+func (keeper Keeper) strictestMessageBasedParams(ctx sdk.Context, messages []sdk.Msg) *v1.MessageBasedParams {
+	paramsByURL := make(map[string]v1.MessageBasedParams)
+	for _, p := range keeper.GetMessageBasedParams(ctx) {
+		paramsByURL[p.MsgUrl] = p
+	}
+
+	var (
+		strictest  *v1.MessageBasedParams
+		usesGlobal bool
+		globalVP   = keeper.GetVotingParams(ctx)
+		globalTP   = keeper.GetTallyParams(ctx)
+	)
+	for _, msg := range messages {
+		p, ok := paramsByURL[sdk.MsgTypeURL(msg)]
+		if !ok {
+			usesGlobal = true
+			continue
+		}
+
+		if strictest == nil || p.StricterThan(strictest.VotingParams(), strictest.TallyParams()) {
+			p := p
+			strictest = &p
+		}
+	}
+
+	if strictest != nil && usesGlobal && !strictest.StricterThan(globalVP, globalTP) {
+		return nil
+	}
+
+	return strictest
+}
+
 // GetProposal get proposal from store by ProposalID.
 // Panics if can't unmarshal the proposal.
 func (keeper Keeper) GetProposal(ctx sdk.Context, proposalID uint64) (v1.Proposal, bool) {
@@ -237,8 +275,7 @@ func (keeper Keeper) SetProposalID(ctx sdk.Context, proposalID uint64) {
 func (keeper Keeper) ActivateVotingPeriod(ctx sdk.Context, proposal v1.Proposal) {
 	startTime := ctx.BlockHeader().Time
 	proposal.VotingStartTime = &startTime
-	votingPeriod := keeper.GetVotingParams(ctx).VotingPeriod
-	endTime := proposal.VotingStartTime.Add(*votingPeriod)
+	endTime := proposal.VotingStartTime.Add(keeper.ProposalVotingPeriod(ctx, proposal))
 	proposal.VotingEndTime = &endTime
 	proposal.Status = v1.StatusVotingPeriod
 	keeper.SetProposal(ctx, proposal)
@@ -247,6 +284,21 @@ func (keeper Keeper) ActivateVotingPeriod(ctx sdk.Context, proposal v1.Proposal)
 	keeper.InsertActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
 }
 
+// ProposalVotingPeriod returns the length of the voting period of a proposal:
+// the expedited voting period if it is expedited, or else the voting period
+// of its MessageBasedParams or of the VotingParams.
+func (keeper Keeper) ProposalVotingPeriod(ctx sdk.Context, proposal v1.Proposal) time.Duration {
+	votingParams := keeper.GetVotingParams(ctx)
+	switch {
+	case proposal.Expedited:
+		return *votingParams.ExpeditedVotingPeriod
+	case proposal.MessageBasedParams != nil:
+		return *proposal.MessageBasedParams.VotingPeriod
+	default:
+		return *votingParams.VotingPeriod
+	}
+}
+
 func (keeper Keeper) MarshalProposal(proposal v1.Proposal) ([]byte, error) {
 	bz, err := keeper.cdc.Marshal(&proposal)
 	if err != nil {
@@ -11,6 +11,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
@@ -18,7 +19,7 @@ import (
 
 func (suite *KeeperTestSuite) TestGetSetProposal() {
 	tp := TestProposal
-	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp, "")
+	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp, "", false)
 	suite.Require().NoError(err)
 	proposalID := proposal.Id
 	suite.app.GovKeeper.SetProposal(suite.ctx, proposal)
@@ -30,7 +31,7 @@ func (suite *KeeperTestSuite) TestGetSetProposal() {
 
 func (suite *KeeperTestSuite) TestActivateVotingPeriod() {
 	tp := TestProposal
-	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp, "")
+	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp, "", false)
 	suite.Require().NoError(err)
 
 	suite.Require().Nil(proposal.VotingStartTime)
@@ -49,6 +50,47 @@ func (suite *KeeperTestSuite) TestActivateVotingPeriod() {
 	activeIterator.Close()
 }
 
+func (suite *KeeperTestSuite) TestSubmitProposalMessageBasedParams() {
+	sendURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	legacyURL := sdk.MsgTypeURL(&v1.MsgExecLegacyContent{})
+	strict := v1.NewMessageBasedParams(sendURL, time.Hour*24*7, v1.DefaultQuorum, sdk.NewDecWithPrec(67, 2), v1.DefaultVetoThreshold)
+	lax := v1.NewMessageBasedParams(legacyURL, time.Hour, v1.DefaultQuorum, sdk.NewDecWithPrec(4, 1), v1.DefaultVetoThreshold)
+
+	testCases := []struct {
+		name     string
+		params   []v1.MessageBasedParams
+		msgs     []sdk.Msg
+		expected *v1.MessageBasedParams
+	}{
+		{"no message based params", nil, TestProposal, nil},
+		{"single override", []v1.MessageBasedParams{strict}, TestProposal[:1], &strict},
+		{"lax override for every message", []v1.MessageBasedParams{lax}, TestProposal[1:], &lax},
+		{"strictest override", []v1.MessageBasedParams{strict, lax}, TestProposal, &strict},
+		{"strict override with global params", []v1.MessageBasedParams{strict}, TestProposal, &strict},
+		{"lax override with global params", []v1.MessageBasedParams{lax}, TestProposal, nil},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.app.GovKeeper.SetMessageBasedParams(suite.ctx, tc.params)
+
+			proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tc.msgs, "", false)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expected, proposal.MessageBasedParams)
+
+			suite.app.GovKeeper.ActivateVotingPeriod(suite.ctx, proposal)
+			proposal, ok := suite.app.GovKeeper.GetProposal(suite.ctx, proposal.Id)
+			suite.Require().True(ok)
+
+			votingPeriod := suite.app.GovKeeper.GetVotingParams(suite.ctx).VotingPeriod
+			if tc.expected != nil {
+				votingPeriod = tc.expected.VotingPeriod
+			}
+			suite.Require().Equal(proposal.VotingStartTime.Add(*votingPeriod), *proposal.VotingEndTime)
+		})
+	}
+}
+
 type invalidProposalRoute struct{ v1beta1.TextProposal }
 
 func (invalidProposalRoute) ProposalRoute() string { return "nonexistingroute" }
@@ -81,7 +123,7 @@ func (suite *KeeperTestSuite) TestSubmitProposal() {
 	for i, tc := range testCases {
 		prop, err := v1.NewLegacyContent(tc.content, tc.authority)
 		suite.Require().NoError(err)
-		_, err = suite.app.GovKeeper.SubmitProposal(suite.ctx, []sdk.Msg{prop}, tc.metadata)
+		_, err = suite.app.GovKeeper.SubmitProposal(suite.ctx, []sdk.Msg{prop}, tc.metadata, false)
 		suite.Require().True(errors.Is(tc.expectedErr, err), "tc #%d; got: %v, expected: %v", i, err, tc.expectedErr)
 	}
 }
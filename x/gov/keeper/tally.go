@@ -92,7 +92,11 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool,
 		totalVotingPower = totalVotingPower.Add(votingPower)
 	}
 
-	tallyParams := keeper.GetTallyParams(ctx)
+	globalTallyParams := keeper.GetTallyParams(ctx)
+	tallyParams := globalTallyParams
+	if proposal.MessageBasedParams != nil {
+		tallyParams = proposal.MessageBasedParams.TallyParams()
+	}
 	tallyResults = v1.NewTallyResultFromMap(results)
 
 	// TODO: Upgrade the spec to cover all of these cases & remove pseudocode.
@@ -121,6 +125,12 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool,
 
 	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
 	threshold, _ := sdk.NewDecFromStr(tallyParams.Threshold)
+	if proposal.Expedited {
+		// Expedited proposals need the expedited threshold, unless the
+		// threshold of their message based params is higher
+		expeditedThreshold, _ := sdk.NewDecFromStr(globalTallyParams.ExpeditedThreshold)
+		threshold = sdk.MaxDec(threshold, expeditedThreshold)
+	}
 	if results[v1.OptionYes].Quo(totalVotingPower.Sub(results[v1.OptionAbstain])).GT(threshold) {
 		return true, false, tallyResults
 	}
@@ -2,6 +2,7 @@ package v046
 
 import (
 	"fmt"
+	"time"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/types"
@@ -165,25 +166,57 @@ func convertToNewVotes(oldVotes v1beta1.Votes) (v1.Votes, error) {
 
 func convertToNewDepParams(oldDepParams v1beta1.DepositParams) v1.DepositParams {
 	return v1.DepositParams{
-		MinDeposit:       oldDepParams.MinDeposit,
-		MaxDepositPeriod: &oldDepParams.MaxDepositPeriod,
+		MinDeposit:          oldDepParams.MinDeposit,
+		MaxDepositPeriod:    &oldDepParams.MaxDepositPeriod,
+		MinExpeditedDeposit: defaultMinExpeditedDeposit(oldDepParams.MinDeposit),
 	}
 }
 
 func convertToNewVotingParams(oldVoteParams v1beta1.VotingParams) v1.VotingParams {
+	expeditedVotingPeriod := defaultExpeditedVotingPeriod(oldVoteParams.VotingPeriod)
 	return v1.VotingParams{
-		VotingPeriod: &oldVoteParams.VotingPeriod,
+		VotingPeriod:          &oldVoteParams.VotingPeriod,
+		ExpeditedVotingPeriod: &expeditedVotingPeriod,
 	}
 }
 
 func convertToNewTallyParams(oldTallyParams v1beta1.TallyParams) v1.TallyParams {
 	return v1.TallyParams{
-		Quorum:        oldTallyParams.Quorum.String(),
-		Threshold:     oldTallyParams.Threshold.String(),
-		VetoThreshold: oldTallyParams.VetoThreshold.String(),
+		Quorum:             oldTallyParams.Quorum.String(),
+		Threshold:          oldTallyParams.Threshold.String(),
+		VetoThreshold:      oldTallyParams.VetoThreshold.String(),
+		ExpeditedThreshold: defaultExpeditedThreshold(oldTallyParams.Threshold).String(),
 	}
 }
 
+// defaultMinExpeditedDeposit returns the minimum expedited deposit of a chain
+// with the given minimum deposit, DefaultMinExpeditedDepositRatio times it.
+func defaultMinExpeditedDeposit(minDeposit types.Coins) types.Coins {
+	minExpeditedDeposit := make(types.Coins, len(minDeposit))
+	for i, coin := range minDeposit {
+		minExpeditedDeposit[i] = types.NewCoin(coin.Denom, coin.Amount.MulRaw(v1.DefaultMinExpeditedDepositRatio))
+	}
+	return minExpeditedDeposit
+}
+
+// defaultExpeditedVotingPeriod returns the expedited voting period of a chain
+// with the given voting period, which must be shorter than it.
+func defaultExpeditedVotingPeriod(votingPeriod time.Duration) time.Duration {
+	if v1.DefaultExpeditedPeriod >= votingPeriod {
+		return votingPeriod / 2
+	}
+	return v1.DefaultExpeditedPeriod
+}
+
+// defaultExpeditedThreshold returns the expedited threshold of a chain with
+// the given threshold, which must be greater than it.
+func defaultExpeditedThreshold(threshold types.Dec) types.Dec {
+	if v1.DefaultExpeditedThreshold.LTE(threshold) {
+		return threshold.Add(types.OneDec()).QuoInt64(2)
+	}
+	return v1.DefaultExpeditedThreshold
+}
+
 func convertToNewProposal(oldProp v1beta1.Proposal) (v1.Proposal, error) {
 	msg, err := v1.NewLegacyContent(oldProp.GetContent(), authtypes.NewModuleAddress(ModuleName).String())
 	if err != nil {
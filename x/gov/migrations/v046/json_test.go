@@ -80,12 +80,20 @@ func TestMigrateJSON(t *testing.T) {
 				"amount": "10000000",
 				"denom": "stake"
 			}
+		],
+		"min_expedited_deposit": [
+			{
+				"amount": "50000000",
+				"denom": "stake"
+			}
 		]
 	},
 	"deposits": [],
+	"message_based_params": [],
 	"proposals": [
 		{
 			"deposit_end_time": "2001-09-09T01:46:40Z",
+			"expedited": false,
 			"final_tally_result": {
 				"abstain_count": "0",
 				"no_count": "0",
@@ -93,6 +101,7 @@ func TestMigrateJSON(t *testing.T) {
 				"yes_count": "0"
 			},
 			"id": "1",
+			"message_based_params": null,
 			"messages": [
 				{
 					"@type": "/cosmos.gov.v1.MsgExecLegacyContent",
@@ -119,6 +128,7 @@ func TestMigrateJSON(t *testing.T) {
 	],
 	"starting_proposal_id": "1",
 	"tally_params": {
+		"expedited_threshold": "0.667000000000000000",
 		"quorum": "0.334000000000000000",
 		"threshold": "0.500000000000000000",
 		"veto_threshold": "0.334000000000000000"
@@ -148,6 +158,7 @@ func TestMigrateJSON(t *testing.T) {
 		}
 	],
 	"voting_params": {
+		"expedited_voting_period": "86400s",
 		"voting_period": "172800s"
 	}
 }`
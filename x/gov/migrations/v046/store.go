@@ -6,6 +6,8 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v040 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v040"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 )
 
@@ -40,12 +42,37 @@ func migrateProposals(store sdk.KVStore, cdc codec.BinaryCodec) error {
 	return nil
 }
 
+// migrateParams sets the params of expedited proposals from the existing
+// deposit, voting and tally params.
+func migrateParams(ctx sdk.Context, paramSpace types.ParamSubspace) {
+	var (
+		depParams    v1.DepositParams
+		votingParams v1.VotingParams
+		tallyParams  v1.TallyParams
+	)
+	paramSpace.Get(ctx, v1.ParamStoreKeyDepositParams, &depParams)
+	paramSpace.Get(ctx, v1.ParamStoreKeyVotingParams, &votingParams)
+	paramSpace.Get(ctx, v1.ParamStoreKeyTallyParams, &tallyParams)
+
+	depParams.MinExpeditedDeposit = defaultMinExpeditedDeposit(depParams.MinDeposit)
+	expeditedVotingPeriod := defaultExpeditedVotingPeriod(*votingParams.VotingPeriod)
+	votingParams.ExpeditedVotingPeriod = &expeditedVotingPeriod
+	tallyParams.ExpeditedThreshold = defaultExpeditedThreshold(sdk.MustNewDecFromStr(tallyParams.Threshold)).String()
+
+	paramSpace.Set(ctx, v1.ParamStoreKeyDepositParams, depParams)
+	paramSpace.Set(ctx, v1.ParamStoreKeyVotingParams, votingParams)
+	paramSpace.Set(ctx, v1.ParamStoreKeyTallyParams, tallyParams)
+}
+
 // MigrateStore performs in-place store migrations from v0.43 to v0.46. The
 // migration includes:
 //
 // - Migrate proposals to be Msg-based.
-func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+// - Set the params of expedited proposals.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec, paramSpace types.ParamSubspace) error {
 	store := ctx.KVStore(storeKey)
 
+	migrateParams(ctx, paramSpace)
+
 	return migrateProposals(store, cdc)
 }
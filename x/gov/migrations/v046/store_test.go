@@ -13,14 +13,26 @@ import (
 	v046gov "github.com/cosmos/cosmos-sdk/x/gov/migrations/v046"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
 func TestMigrateStore(t *testing.T) {
-	cdc := simapp.MakeTestEncodingConfig().Codec
+	encCfg := simapp.MakeTestEncodingConfig()
+	cdc := encCfg.Codec
 	govKey := sdk.NewKVStoreKey("gov")
-	ctx := testutil.DefaultContext(govKey, sdk.NewTransientStoreKey("transient_test"))
+	tGovKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(govKey, tGovKey)
 	store := ctx.KVStore(govKey)
+	paramstore := paramtypes.NewSubspace(cdc, encCfg.Amino, govKey, tGovKey, "gov").WithKeyTable(v1.ParamKeyTable())
+
+	// Set the params without the expedited ones
+	oldDepParams, oldVotingPeriod, oldTallyParams := v1.DefaultDepositParams(), time.Hour*12, v1.DefaultTallyParams()
+	oldDepParams.MinExpeditedDeposit = nil
+	oldTallyParams.ExpeditedThreshold = ""
+	paramstore.Set(ctx, v1.ParamStoreKeyDepositParams, oldDepParams)
+	paramstore.Set(ctx, v1.ParamStoreKeyVotingParams, v1.VotingParams{VotingPeriod: &oldVotingPeriod})
+	paramstore.Set(ctx, v1.ParamStoreKeyTallyParams, oldTallyParams)
 
 	propTime := time.Unix(1e9, 0)
 
@@ -40,9 +52,22 @@ func TestMigrateStore(t *testing.T) {
 	store.Set(v040gov.ProposalKey(prop2.ProposalId), prop2Bz)
 
 	// Run migrations.
-	err = v046gov.MigrateStore(ctx, govKey, cdc)
+	err = v046gov.MigrateStore(ctx, govKey, cdc, paramstore)
 	require.NoError(t, err)
 
+	var (
+		depParams    v1.DepositParams
+		votingParams v1.VotingParams
+		tallyParams  v1.TallyParams
+	)
+	paramstore.Get(ctx, v1.ParamStoreKeyDepositParams, &depParams)
+	paramstore.Get(ctx, v1.ParamStoreKeyVotingParams, &votingParams)
+	paramstore.Get(ctx, v1.ParamStoreKeyTallyParams, &tallyParams)
+	require.Equal(t, v1.DefaultDepositParams(), depParams)
+	require.Equal(t, oldVotingPeriod, *votingParams.VotingPeriod)
+	require.Equal(t, oldVotingPeriod/2, *votingParams.ExpeditedVotingPeriod)
+	require.Equal(t, v1.DefaultTallyParams(), tallyParams)
+
 	var newProp1 v1.Proposal
 	err = cdc.Unmarshal(store.Get(v040gov.ProposalKey(prop1.ProposalId)), &newProp1)
 	require.NoError(t, err)
@@ -51,6 +51,9 @@ func TestRandomizedGenState(t *testing.T) {
 	require.Equal(t, dec1.String(), govGenesis.TallyParams.Quorum)
 	require.Equal(t, dec2.String(), govGenesis.TallyParams.Threshold)
 	require.Equal(t, dec3.String(), govGenesis.TallyParams.VetoThreshold)
+	require.Equal(t, "1810stake", govGenesis.DepositParams.MinExpeditedDeposit[0].String())
+	require.Equal(t, "38h12m11.838212036s", govGenesis.VotingParams.ExpeditedVotingPeriod.String())
+	require.Equal(t, "0.531000000000000000", govGenesis.TallyParams.ExpeditedThreshold)
 	require.Equal(t, uint64(0x28), govGenesis.StartingProposalId)
 	require.Equal(t, []*v1.Deposit{}, govGenesis.Deposits)
 	require.Equal(t, []*v1.Vote{}, govGenesis.Votes)
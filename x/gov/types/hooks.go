@@ -40,3 +40,11 @@ func (h MultiGovHooks) AfterProposalVotingPeriodEnded(ctx sdk.Context, proposalI
 		h[i].AfterProposalVotingPeriodEnded(ctx, proposalID)
 	}
 }
+
+// GovHooksWrapper is a wrapper for modules to inject GovHooks using the
+// dependency injection container. The hooks of all modules are combined in
+// the order in which the modules appear in the app config.
+type GovHooksWrapper struct{ GovHooks }
+
+// IsAutoGroupType implements the container.AutoGroupType interface.
+func (GovHooksWrapper) IsAutoGroupType() {}
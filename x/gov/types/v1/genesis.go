@@ -53,6 +53,10 @@ func ValidateGenesis(data *GenesisState) error {
 		return fmt.Errorf("invalid deposit params: %w", err)
 	}
 
+	if err := validateMessageBasedParams(data.MessageBasedParams); err != nil {
+		return fmt.Errorf("invalid message based params: %w", err)
+	}
+
 	return nil
 }
 
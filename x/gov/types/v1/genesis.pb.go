@@ -5,6 +5,7 @@ package v1
 
 import (
 	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -38,6 +39,9 @@ type GenesisState struct {
 	VotingParams *VotingParams `protobuf:"bytes,6,opt,name=voting_params,json=votingParams,proto3" json:"voting_params,omitempty"`
 	// params defines all the paramaters of related to tally.
 	TallyParams *TallyParams `protobuf:"bytes,7,opt,name=tally_params,json=tallyParams,proto3" json:"tally_params,omitempty"`
+	// message_based_params defines the params overriding the voting and tally
+	// params for the proposals containing given message types.
+	MessageBasedParams []MessageBasedParams `protobuf:"bytes,8,rep,name=message_based_params,json=messageBasedParams,proto3" json:"message_based_params"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -122,6 +126,13 @@ func (m *GenesisState) GetTallyParams() *TallyParams {
 	return nil
 }
 
+func (m *GenesisState) GetMessageBasedParams() []MessageBasedParams {
+	if m != nil {
+		return m.MessageBasedParams
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.gov.v1.GenesisState")
 }
@@ -129,29 +140,32 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1/genesis.proto", fileDescriptor_ef7cfd15e3ded621) }
 
 var fileDescriptor_ef7cfd15e3ded621 = []byte{
-	// 341 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xcd, 0x4e, 0xc2, 0x40,
-	0x14, 0x85, 0xa9, 0xfc, 0xa8, 0x03, 0xb8, 0x18, 0x8d, 0x34, 0x60, 0x1a, 0xe2, 0x0a, 0x63, 0x6c,
-	0x05, 0xe3, 0xd2, 0xc4, 0xf8, 0x13, 0xe3, 0x8e, 0x54, 0xe3, 0xc2, 0x0d, 0x29, 0x74, 0x52, 0x1b,
-	0x81, 0xdb, 0xf4, 0x5e, 0x27, 0xf2, 0x16, 0x3e, 0x80, 0x0f, 0xe4, 0x92, 0xa5, 0x4b, 0x03, 0x2f,
-	0x62, 0x98, 0x69, 0x05, 0xab, 0xab, 0xc9, 0xcd, 0xf9, 0xce, 0x99, 0x93, 0x7b, 0x59, 0x63, 0x00,
-	0x38, 0x02, 0x74, 0x02, 0x90, 0x8e, 0x6c, 0x3b, 0x81, 0x18, 0x0b, 0x0c, 0xd1, 0x8e, 0x62, 0x20,
-	0xe0, 0x55, 0x2d, 0xda, 0x01, 0x48, 0x5b, 0xb6, 0xeb, 0xb5, 0x0c, 0x0b, 0x52, 0x73, 0xfb, 0xef,
-	0x79, 0x56, 0xb9, 0xd1, 0xce, 0x3b, 0xf2, 0x48, 0xf0, 0x63, 0xb6, 0x83, 0xe4, 0xc5, 0x14, 0x8e,
-	0x83, 0x5e, 0x14, 0x43, 0x04, 0xe8, 0x0d, 0x7b, 0xa1, 0x6f, 0x1a, 0x4d, 0xa3, 0x55, 0x70, 0x79,
-	0xaa, 0x75, 0x13, 0xe9, 0xd6, 0xe7, 0x1d, 0xb6, 0xe1, 0x8b, 0x08, 0x30, 0x24, 0x34, 0xd7, 0x9a,
-	0xf9, 0x56, 0xb9, 0xb3, 0x6b, 0xff, 0xfa, 0xdd, 0xbe, 0xd2, 0xb2, 0xfb, 0xc3, 0xf1, 0x03, 0x56,
-	0x94, 0x40, 0x02, 0xcd, 0xbc, 0x32, 0x6c, 0x67, 0x0c, 0x0f, 0x40, 0xc2, 0xd5, 0x04, 0x3f, 0x65,
-	0x9b, 0x69, 0x0f, 0x34, 0x0b, 0x0a, 0xaf, 0x65, 0xf0, 0xb4, 0x8c, 0xbb, 0x24, 0xf9, 0x25, 0xdb,
-	0x4a, 0x7e, 0xeb, 0x45, 0x5e, 0xec, 0x8d, 0xd0, 0x2c, 0x36, 0x8d, 0x56, 0xb9, 0xb3, 0xf7, 0x7f,
-	0xb7, 0xae, 0x62, 0xdc, 0xaa, 0xbf, 0x3a, 0xf2, 0x73, 0x56, 0x95, 0xa0, 0x57, 0xa1, 0x33, 0x4a,
-	0x2a, 0xa3, 0xf1, 0xb7, 0xee, 0x62, 0x25, 0x3a, 0xa2, 0x22, 0x57, 0x26, 0x7e, 0xc6, 0x2a, 0xe4,
-	0x0d, 0x87, 0x93, 0x34, 0x60, 0x5d, 0x05, 0xd4, 0x33, 0x01, 0xf7, 0x0b, 0x24, 0xf1, 0x97, 0x69,
-	0x39, 0x5c, 0x5c, 0x7f, 0xcc, 0x2c, 0x63, 0x3a, 0xb3, 0x8c, 0xaf, 0x99, 0x65, 0xbc, 0xcd, 0xad,
-	0xdc, 0x74, 0x6e, 0xe5, 0x3e, 0xe7, 0x56, 0xee, 0xf1, 0x30, 0x08, 0xe9, 0xe9, 0xa5, 0x6f, 0x0f,
-	0x60, 0xe4, 0x24, 0xc7, 0xd5, 0xcf, 0x11, 0xfa, 0xcf, 0xce, 0xab, 0xba, 0x34, 0x4d, 0x22, 0x81,
-	0x8e, 0x6c, 0xf7, 0x4b, 0xea, 0xd8, 0x27, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xf3, 0xec, 0x74,
-	0x03, 0x33, 0x02, 0x00, 0x00,
+	// 388 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcf, 0xaa, 0xd3, 0x40,
+	0x14, 0x87, 0x13, 0xfb, 0xc7, 0x3a, 0x6d, 0x5d, 0x8c, 0xc5, 0x86, 0x56, 0x62, 0x75, 0x55, 0x11,
+	0x13, 0x5b, 0x71, 0x29, 0x48, 0x55, 0xc4, 0x85, 0x50, 0xa2, 0x08, 0xba, 0x09, 0xd3, 0x66, 0x18,
+	0x83, 0x4d, 0x4f, 0xc8, 0x19, 0x07, 0xfb, 0x16, 0x3e, 0x56, 0x97, 0x5d, 0xba, 0x92, 0x4b, 0xfb,
+	0x10, 0x77, 0x7b, 0xc9, 0x4c, 0x72, 0xdb, 0x9b, 0xde, 0x55, 0x72, 0xf8, 0x7d, 0xe7, 0x9b, 0xc3,
+	0xe1, 0x90, 0xe1, 0x12, 0x30, 0x01, 0xf4, 0x05, 0x28, 0x5f, 0x4d, 0x7c, 0xc1, 0xd7, 0x1c, 0x63,
+	0xf4, 0xd2, 0x0c, 0x24, 0xd0, 0xae, 0x09, 0x3d, 0x01, 0xca, 0x53, 0x93, 0x41, 0x4f, 0x80, 0x00,
+	0x9d, 0xf8, 0xf9, 0x9f, 0x81, 0x06, 0xfd, 0x8a, 0x01, 0x94, 0x09, 0x9e, 0x5e, 0xd6, 0x48, 0xe7,
+	0xa3, 0xf1, 0x7d, 0x91, 0x4c, 0x72, 0xfa, 0x92, 0xf4, 0x50, 0xb2, 0x4c, 0xc6, 0x6b, 0x11, 0xa6,
+	0x19, 0xa4, 0x80, 0x6c, 0x15, 0xc6, 0x91, 0x63, 0x8f, 0xec, 0x71, 0x3d, 0xa0, 0x65, 0x36, 0x2f,
+	0xa2, 0x4f, 0x11, 0x9d, 0x92, 0x56, 0xc4, 0x53, 0xc0, 0x58, 0xa2, 0x73, 0x67, 0x54, 0x1b, 0xb7,
+	0xa7, 0x0f, 0xbd, 0x1b, 0x33, 0x79, 0xef, 0x4d, 0x1c, 0x5c, 0x73, 0xf4, 0x19, 0x69, 0x28, 0x90,
+	0x1c, 0x9d, 0x9a, 0x6e, 0x78, 0x50, 0x69, 0xf8, 0x06, 0x92, 0x07, 0x86, 0xa0, 0xaf, 0xc9, 0xbd,
+	0x72, 0x0e, 0x74, 0xea, 0x1a, 0xef, 0x57, 0xf0, 0x72, 0x98, 0xe0, 0x48, 0xd2, 0x77, 0xe4, 0x7e,
+	0xf1, 0x5a, 0x98, 0xb2, 0x8c, 0x25, 0xe8, 0x34, 0x46, 0xf6, 0xb8, 0x3d, 0x7d, 0x74, 0xfb, 0x6c,
+	0x73, 0xcd, 0x04, 0xdd, 0xe8, 0xb4, 0xa4, 0x6f, 0x49, 0x57, 0x81, 0x59, 0x85, 0x71, 0x34, 0xb5,
+	0x63, 0x78, 0x3e, 0x6e, 0xbe, 0x12, 0xa3, 0xe8, 0xa8, 0x93, 0x8a, 0xbe, 0x21, 0x1d, 0xc9, 0x56,
+	0xab, 0x4d, 0x29, 0xb8, 0xab, 0x05, 0x83, 0x8a, 0xe0, 0x6b, 0x8e, 0x14, 0xfd, 0x6d, 0x79, 0x2c,
+	0xe8, 0x77, 0xd2, 0x4b, 0x38, 0x22, 0x13, 0x3c, 0x5c, 0x30, 0xe4, 0x51, 0xa9, 0x69, 0xe9, 0x3d,
+	0x3c, 0xa9, 0x68, 0x3e, 0x1b, 0x74, 0x96, 0x93, 0x46, 0x30, 0xab, 0x6f, 0xff, 0x3f, 0xb6, 0x02,
+	0x9a, 0x9c, 0x27, 0x1f, 0xb6, 0x7b, 0xd7, 0xde, 0xed, 0x5d, 0xfb, 0x62, 0xef, 0xda, 0x7f, 0x0f,
+	0xae, 0xb5, 0x3b, 0xb8, 0xd6, 0xbf, 0x83, 0x6b, 0xfd, 0x78, 0x2e, 0x62, 0xf9, 0xf3, 0xf7, 0xc2,
+	0x5b, 0x42, 0xe2, 0x17, 0x77, 0x63, 0x3e, 0x2f, 0x30, 0xfa, 0xe5, 0xff, 0xd1, 0x47, 0x24, 0x37,
+	0x29, 0x47, 0x5f, 0x4d, 0x16, 0x4d, 0x7d, 0x47, 0xaf, 0xae, 0x06, 0x00, 0x24, 0xc2, 0x77, 0x88,
+	0xa4, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -174,6 +188,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MessageBasedParams) > 0 {
+		for iNdEx := len(m.MessageBasedParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MessageBasedParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if m.TallyParams != nil {
 		{
 			size, err := m.TallyParams.MarshalToSizedBuffer(dAtA[:i])
@@ -310,6 +338,12 @@ func (m *GenesisState) Size() (n int) {
 		l = m.TallyParams.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.MessageBasedParams) > 0 {
+		for _, e := range m.MessageBasedParams {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -577,6 +611,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageBasedParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageBasedParams = append(m.MessageBasedParams, MessageBasedParams{})
+			if err := m.MessageBasedParams[len(m.MessageBasedParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -11,22 +11,31 @@ import (
 
 // Default period for deposits & voting
 const (
-	DefaultPeriod time.Duration = time.Hour * 24 * 2 // 2 days
+	DefaultPeriod          time.Duration = time.Hour * 24 * 2 // 2 days
+	DefaultExpeditedPeriod time.Duration = time.Hour * 24     // 1 day
 )
 
 // Default governance params
 var (
-	DefaultMinDepositTokens = sdk.NewInt(10000000)
-	DefaultQuorum           = sdk.NewDecWithPrec(334, 3)
-	DefaultThreshold        = sdk.NewDecWithPrec(5, 1)
-	DefaultVetoThreshold    = sdk.NewDecWithPrec(334, 3)
+	DefaultMinDepositTokens          = sdk.NewInt(10000000)
+	DefaultMinExpeditedDepositTokens = DefaultMinDepositTokens.MulRaw(DefaultMinExpeditedDepositRatio)
+	DefaultQuorum                    = sdk.NewDecWithPrec(334, 3)
+	DefaultThreshold                 = sdk.NewDecWithPrec(5, 1)
+	DefaultVetoThreshold             = sdk.NewDecWithPrec(334, 3)
+	DefaultExpeditedThreshold        = sdk.NewDecWithPrec(667, 3)
 )
 
+// DefaultMinExpeditedDepositRatio is the default ratio between the minimum
+// deposits of expedited and regular proposals.
+const DefaultMinExpeditedDepositRatio = 5
+
 // Parameter store key
 var (
 	ParamStoreKeyDepositParams = []byte("depositparams")
 	ParamStoreKeyVotingParams  = []byte("votingparams")
 	ParamStoreKeyTallyParams   = []byte("tallyparams")
+
+	ParamStoreKeyMessageBasedParams = []byte("messagebasedparams")
 )
 
 // ParamKeyTable - Key declaration for parameters
@@ -35,14 +44,16 @@ func ParamKeyTable() paramtypes.KeyTable {
 		paramtypes.NewParamSetPair(ParamStoreKeyDepositParams, DepositParams{}, validateDepositParams),
 		paramtypes.NewParamSetPair(ParamStoreKeyVotingParams, VotingParams{}, validateVotingParams),
 		paramtypes.NewParamSetPair(ParamStoreKeyTallyParams, TallyParams{}, validateTallyParams),
+		paramtypes.NewParamSetPair(ParamStoreKeyMessageBasedParams, []MessageBasedParams{}, validateMessageBasedParams),
 	)
 }
 
 // NewDepositParams creates a new DepositParams object
-func NewDepositParams(minDeposit sdk.Coins, maxDepositPeriod time.Duration) DepositParams {
+func NewDepositParams(minDeposit sdk.Coins, maxDepositPeriod time.Duration, minExpeditedDeposit sdk.Coins) DepositParams {
 	return DepositParams{
-		MinDeposit:       minDeposit,
-		MaxDepositPeriod: &maxDepositPeriod,
+		MinDeposit:          minDeposit,
+		MaxDepositPeriod:    &maxDepositPeriod,
+		MinExpeditedDeposit: minExpeditedDeposit,
 	}
 }
 
@@ -51,12 +62,23 @@ func DefaultDepositParams() DepositParams {
 	return NewDepositParams(
 		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinDepositTokens)),
 		DefaultPeriod,
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinExpeditedDepositTokens)),
 	)
 }
 
 // Equal checks equality of DepositParams
 func (dp DepositParams) Equal(dp2 DepositParams) bool {
-	return sdk.Coins(dp.MinDeposit).IsEqual(dp2.MinDeposit) && dp.MaxDepositPeriod == dp2.MaxDepositPeriod
+	return sdk.Coins(dp.MinDeposit).IsEqual(dp2.MinDeposit) && dp.MaxDepositPeriod == dp2.MaxDepositPeriod &&
+		sdk.Coins(dp.MinExpeditedDeposit).IsEqual(dp2.MinExpeditedDeposit)
+}
+
+// MinDepositFor returns the minimum deposit for a proposal to enter voting
+// period, MinExpeditedDeposit if the proposal is expedited.
+func (dp DepositParams) MinDepositFor(expedited bool) sdk.Coins {
+	if expedited {
+		return dp.MinExpeditedDeposit
+	}
+	return dp.MinDeposit
 }
 
 func validateDepositParams(i interface{}) error {
@@ -71,27 +93,36 @@ func validateDepositParams(i interface{}) error {
 	if v.MaxDepositPeriod == nil || v.MaxDepositPeriod.Seconds() <= 0 {
 		return fmt.Errorf("maximum deposit period must be positive: %d", v.MaxDepositPeriod)
 	}
+	minExpeditedDeposit := sdk.Coins(v.MinExpeditedDeposit)
+	if !minExpeditedDeposit.IsValid() {
+		return fmt.Errorf("invalid minimum expedited deposit: %s", v.MinExpeditedDeposit)
+	}
+	if !minExpeditedDeposit.IsAllGTE(v.MinDeposit) || minExpeditedDeposit.IsEqual(v.MinDeposit) {
+		return fmt.Errorf("minimum expedited deposit must be greater than the minimum deposit: %s <= %s", minExpeditedDeposit, sdk.Coins(v.MinDeposit))
+	}
 
 	return nil
 }
 
 // NewTallyParams creates a new TallyParams object
-func NewTallyParams(quorum, threshold, vetoThreshold sdk.Dec) TallyParams {
+func NewTallyParams(quorum, threshold, vetoThreshold, expeditedThreshold sdk.Dec) TallyParams {
 	return TallyParams{
-		Quorum:        quorum.String(),
-		Threshold:     threshold.String(),
-		VetoThreshold: vetoThreshold.String(),
+		Quorum:             quorum.String(),
+		Threshold:          threshold.String(),
+		VetoThreshold:      vetoThreshold.String(),
+		ExpeditedThreshold: expeditedThreshold.String(),
 	}
 }
 
 // DefaultTallyParams default parameters for tallying
 func DefaultTallyParams() TallyParams {
-	return NewTallyParams(DefaultQuorum, DefaultThreshold, DefaultVetoThreshold)
+	return NewTallyParams(DefaultQuorum, DefaultThreshold, DefaultVetoThreshold, DefaultExpeditedThreshold)
 }
 
 // Equal checks equality of TallyParams
 func (tp TallyParams) Equal(other TallyParams) bool {
-	return tp.Quorum == other.Quorum && tp.Threshold == other.Threshold && tp.VetoThreshold == other.VetoThreshold
+	return tp.Quorum == other.Quorum && tp.Threshold == other.Threshold && tp.VetoThreshold == other.VetoThreshold &&
+		tp.ExpeditedThreshold == other.ExpeditedThreshold
 }
 
 func validateTallyParams(i interface{}) error {
@@ -100,6 +131,28 @@ func validateTallyParams(i interface{}) error {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 
+	if err := validateTallyThresholds(v); err != nil {
+		return err
+	}
+
+	threshold := sdk.MustNewDecFromStr(v.Threshold)
+	expeditedThreshold, err := sdk.NewDecFromStr(v.ExpeditedThreshold)
+	if err != nil {
+		return fmt.Errorf("invalid expedited threshold string: %w", err)
+	}
+	if expeditedThreshold.LTE(threshold) {
+		return fmt.Errorf("expedited vote threshold must be greater than the vote threshold: %s <= %s", expeditedThreshold, threshold)
+	}
+	if expeditedThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("expedited vote threshold too large: %s", v)
+	}
+
+	return nil
+}
+
+// validateTallyThresholds validates the quorum, threshold and veto threshold
+// of the tally params.
+func validateTallyThresholds(v TallyParams) error {
 	quorum, err := sdk.NewDecFromStr(v.Quorum)
 	if err != nil {
 		return fmt.Errorf("invalid quorum string: %w", err)
@@ -137,20 +190,21 @@ func validateTallyParams(i interface{}) error {
 }
 
 // NewVotingParams creates a new VotingParams object
-func NewVotingParams(votingPeriod time.Duration) VotingParams {
+func NewVotingParams(votingPeriod, expeditedVotingPeriod time.Duration) VotingParams {
 	return VotingParams{
-		VotingPeriod: &votingPeriod,
+		VotingPeriod:          &votingPeriod,
+		ExpeditedVotingPeriod: &expeditedVotingPeriod,
 	}
 }
 
 // DefaultVotingParams default parameters for voting
 func DefaultVotingParams() VotingParams {
-	return NewVotingParams(DefaultPeriod)
+	return NewVotingParams(DefaultPeriod, DefaultExpeditedPeriod)
 }
 
 // Equal checks equality of TallyParams
 func (vp VotingParams) Equal(other VotingParams) bool {
-	return vp.VotingPeriod == other.VotingPeriod
+	return vp.VotingPeriod == other.VotingPeriod && vp.ExpeditedVotingPeriod == other.ExpeditedVotingPeriod
 }
 
 func validateVotingParams(i interface{}) error {
@@ -159,6 +213,27 @@ func validateVotingParams(i interface{}) error {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 
+	if err := validateVotingPeriod(v); err != nil {
+		return err
+	}
+
+	if v.ExpeditedVotingPeriod == nil {
+		return errors.New("expedited voting period must not be nil")
+	}
+
+	if v.ExpeditedVotingPeriod.Seconds() <= 0 {
+		return fmt.Errorf("expedited voting period must be positive: %s", v.ExpeditedVotingPeriod)
+	}
+
+	if *v.ExpeditedVotingPeriod >= *v.VotingPeriod {
+		return fmt.Errorf("expedited voting period must be strictly less than the voting period: %s >= %s", v.ExpeditedVotingPeriod, v.VotingPeriod)
+	}
+
+	return nil
+}
+
+// validateVotingPeriod validates the voting period of the voting params.
+func validateVotingPeriod(v VotingParams) error {
 	if v.VotingPeriod == nil {
 		return errors.New("voting period must not be nil")
 	}
@@ -170,6 +245,76 @@ func validateVotingParams(i interface{}) error {
 	return nil
 }
 
+// NewMessageBasedParams creates a new MessageBasedParams object
+func NewMessageBasedParams(msgURL string, votingPeriod time.Duration, quorum, threshold, vetoThreshold sdk.Dec) MessageBasedParams {
+	return MessageBasedParams{
+		MsgUrl:        msgURL,
+		VotingPeriod:  &votingPeriod,
+		Quorum:        quorum.String(),
+		Threshold:     threshold.String(),
+		VetoThreshold: vetoThreshold.String(),
+	}
+}
+
+// VotingParams returns the VotingParams overridden by the MessageBasedParams.
+func (p MessageBasedParams) VotingParams() VotingParams {
+	return VotingParams{VotingPeriod: p.VotingPeriod}
+}
+
+// TallyParams returns the TallyParams overridden by the MessageBasedParams.
+func (p MessageBasedParams) TallyParams() TallyParams {
+	return TallyParams{
+		Quorum:        p.Quorum,
+		Threshold:     p.Threshold,
+		VetoThreshold: p.VetoThreshold,
+	}
+}
+
+// StricterThan returns whether p makes it harder for proposals to pass than
+// the provided voting and tally params, comparing in order the threshold,
+// the quorum, the veto threshold, lower being stricter, and the voting period.
+func (p MessageBasedParams) StricterThan(vp VotingParams, tp TallyParams) bool {
+	for _, cmp := range []struct{ a, b string }{
+		{p.Threshold, tp.Threshold},
+		{p.Quorum, tp.Quorum},
+		{tp.VetoThreshold, p.VetoThreshold},
+	} {
+		a, b := sdk.MustNewDecFromStr(cmp.a), sdk.MustNewDecFromStr(cmp.b)
+		if !a.Equal(b) {
+			return a.GT(b)
+		}
+	}
+
+	return *p.VotingPeriod > *vp.VotingPeriod
+}
+
+func validateMessageBasedParams(i interface{}) error {
+	v, ok := i.([]MessageBasedParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	msgURLs := make(map[string]bool, len(v))
+	for _, p := range v {
+		if p.MsgUrl == "" {
+			return errors.New("message based params msg url cannot be empty")
+		}
+		if msgURLs[p.MsgUrl] {
+			return fmt.Errorf("duplicate message based params for %s", p.MsgUrl)
+		}
+		msgURLs[p.MsgUrl] = true
+
+		if err := validateVotingPeriod(p.VotingParams()); err != nil {
+			return fmt.Errorf("invalid message based params for %s: %w", p.MsgUrl, err)
+		}
+		if err := validateTallyThresholds(p.TallyParams()); err != nil {
+			return fmt.Errorf("invalid message based params for %s: %w", p.MsgUrl, err)
+		}
+	}
+
+	return nil
+}
+
 // Params returns all of the governance params
 type Params struct {
 	VotingParams  VotingParams  `json:"voting_params" yaml:"voting_params"`
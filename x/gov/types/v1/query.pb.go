@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -873,6 +874,91 @@ func (m *QueryTallyResultResponse) GetTally() *TallyResult {
 	return nil
 }
 
+// QueryMessageBasedParamsRequest is the request type for the
+// Query/MessageBasedParams RPC method.
+type QueryMessageBasedParamsRequest struct {
+}
+
+func (m *QueryMessageBasedParamsRequest) Reset()         { *m = QueryMessageBasedParamsRequest{} }
+func (m *QueryMessageBasedParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMessageBasedParamsRequest) ProtoMessage()    {}
+func (*QueryMessageBasedParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{16}
+}
+func (m *QueryMessageBasedParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMessageBasedParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMessageBasedParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMessageBasedParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMessageBasedParamsRequest.Merge(m, src)
+}
+func (m *QueryMessageBasedParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMessageBasedParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMessageBasedParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMessageBasedParamsRequest proto.InternalMessageInfo
+
+// QueryMessageBasedParamsResponse is the response type for the
+// Query/MessageBasedParams RPC method.
+type QueryMessageBasedParamsResponse struct {
+	// params defines the params of the message types which have some.
+	Params []MessageBasedParams `protobuf:"bytes,1,rep,name=params,proto3" json:"params"`
+}
+
+func (m *QueryMessageBasedParamsResponse) Reset()         { *m = QueryMessageBasedParamsResponse{} }
+func (m *QueryMessageBasedParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMessageBasedParamsResponse) ProtoMessage()    {}
+func (*QueryMessageBasedParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{17}
+}
+func (m *QueryMessageBasedParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMessageBasedParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMessageBasedParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMessageBasedParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMessageBasedParamsResponse.Merge(m, src)
+}
+func (m *QueryMessageBasedParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMessageBasedParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMessageBasedParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMessageBasedParamsResponse proto.InternalMessageInfo
+
+func (m *QueryMessageBasedParamsResponse) GetParams() []MessageBasedParams {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryProposalRequest)(nil), "cosmos.gov.v1.QueryProposalRequest")
 	proto.RegisterType((*QueryProposalResponse)(nil), "cosmos.gov.v1.QueryProposalResponse")
@@ -890,72 +976,79 @@ func init() {
 	proto.RegisterType((*QueryDepositsResponse)(nil), "cosmos.gov.v1.QueryDepositsResponse")
 	proto.RegisterType((*QueryTallyResultRequest)(nil), "cosmos.gov.v1.QueryTallyResultRequest")
 	proto.RegisterType((*QueryTallyResultResponse)(nil), "cosmos.gov.v1.QueryTallyResultResponse")
+	proto.RegisterType((*QueryMessageBasedParamsRequest)(nil), "cosmos.gov.v1.QueryMessageBasedParamsRequest")
+	proto.RegisterType((*QueryMessageBasedParamsResponse)(nil), "cosmos.gov.v1.QueryMessageBasedParamsResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/query.proto", fileDescriptor_46a436d1109b50d0) }
 
 var fileDescriptor_46a436d1109b50d0 = []byte{
-	// 945 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x5d, 0x6f, 0xdc, 0x44,
-	0x14, 0xcd, 0x6c, 0x92, 0x76, 0xf7, 0xa6, 0x09, 0x70, 0x5b, 0x9a, 0xc5, 0x94, 0x6d, 0x70, 0x68,
-	0x12, 0x28, 0xf5, 0xb0, 0xe9, 0x97, 0x04, 0xad, 0x04, 0x2d, 0x04, 0x90, 0x78, 0x08, 0xdb, 0x8a,
-	0x07, 0x5e, 0x22, 0x27, 0x6b, 0x19, 0x8b, 0x8d, 0xc7, 0xdd, 0x99, 0x5d, 0x11, 0xd2, 0x08, 0xa9,
-	0x12, 0x82, 0x27, 0x40, 0xa2, 0x12, 0xfc, 0x10, 0x7e, 0x04, 0x8f, 0x15, 0xbc, 0x20, 0x78, 0x41,
-	0x09, 0x3f, 0x04, 0x79, 0xe6, 0xda, 0x6b, 0x3b, 0xde, 0xcd, 0xa6, 0x8a, 0xfa, 0x14, 0x8d, 0x7d,
-	0xee, 0xb9, 0xe7, 0x7e, 0xf8, 0x4c, 0x16, 0x5e, 0xda, 0x12, 0x72, 0x5b, 0x48, 0xee, 0x8b, 0x3e,
-	0xef, 0x37, 0xf9, 0x83, 0x9e, 0xd7, 0xdd, 0x71, 0xa2, 0xae, 0x50, 0x02, 0x67, 0xcd, 0x2b, 0xc7,
-	0x17, 0x7d, 0xa7, 0xdf, 0xb4, 0xde, 0x20, 0xe4, 0xa6, 0x2b, 0x3d, 0x83, 0xe3, 0xfd, 0xe6, 0xa6,
-	0xa7, 0xdc, 0x26, 0x8f, 0x5c, 0x3f, 0x08, 0x5d, 0x15, 0x88, 0xd0, 0x84, 0x5a, 0x17, 0x7c, 0x21,
-	0xfc, 0x8e, 0xc7, 0xdd, 0x28, 0xe0, 0x6e, 0x18, 0x0a, 0xa5, 0x5f, 0x4a, 0x7a, 0x3b, 0x9f, 0xcf,
-	0x19, 0xf3, 0x9b, 0x17, 0x24, 0x66, 0x43, 0x9f, 0x38, 0xa5, 0xd7, 0x07, 0xfb, 0x26, 0x9c, 0xfb,
-	0x34, 0xce, 0xb9, 0xde, 0x15, 0x91, 0x90, 0x6e, 0xa7, 0xe5, 0x3d, 0xe8, 0x79, 0x52, 0xe1, 0x45,
-	0x98, 0x89, 0xe8, 0xd1, 0x46, 0xd0, 0xae, 0xb3, 0x05, 0xb6, 0x32, 0xd5, 0x82, 0xe4, 0xd1, 0xc7,
-	0x6d, 0xfb, 0x13, 0x78, 0xb1, 0x10, 0x28, 0x23, 0x11, 0x4a, 0x0f, 0xaf, 0x42, 0x35, 0x81, 0xe9,
-	0xb0, 0x99, 0xd5, 0x79, 0x27, 0x57, 0xb1, 0x93, 0x86, 0xa4, 0x40, 0xfb, 0xc7, 0x4a, 0x81, 0x4e,
-	0x26, 0x42, 0xd6, 0xe0, 0xb9, 0x54, 0x88, 0x54, 0xae, 0xea, 0x49, 0xcd, 0x3a, 0xb7, 0xfa, 0xca,
-	0x10, 0xd6, 0x7b, 0x1a, 0xd4, 0x9a, 0x8b, 0x72, 0x67, 0x74, 0x60, 0xba, 0x2f, 0x94, 0xd7, 0xad,
-	0x57, 0x16, 0xd8, 0x4a, 0xed, 0x4e, 0xfd, 0x8f, 0xdf, 0xae, 0x9c, 0x23, 0x82, 0xf7, 0xda, 0xed,
-	0xae, 0x27, 0xe5, 0x3d, 0xd5, 0x0d, 0x42, 0xbf, 0x65, 0x60, 0x78, 0x03, 0x6a, 0x6d, 0x2f, 0x12,
-	0x32, 0x50, 0xa2, 0x5b, 0x9f, 0x3c, 0x22, 0x66, 0x00, 0xc5, 0x35, 0x80, 0xc1, 0xd8, 0xea, 0x53,
-	0xba, 0x01, 0x4b, 0x89, 0xd4, 0x78, 0xc6, 0x8e, 0xd9, 0x05, 0x9a, 0xb1, 0xb3, 0xee, 0xfa, 0x1e,
-	0xd5, 0xda, 0xca, 0x44, 0xda, 0xbf, 0x32, 0x38, 0x5f, 0xec, 0x08, 0x75, 0xf8, 0x3a, 0xd4, 0x92,
-	0xe2, 0xe2, 0x66, 0x4c, 0x8e, 0x6a, 0xf1, 0x00, 0x89, 0x1f, 0xe6, 0x94, 0x55, 0xb4, 0xb2, 0xe5,
-	0x23, 0x95, 0x99, 0x9c, 0x39, 0x69, 0x5b, 0xf0, 0xbc, 0x56, 0xf6, 0x99, 0x50, 0xde, 0xb8, 0xfb,
-	0x72, 0xdc, 0xfe, 0xdb, 0xb7, 0xe0, 0x85, 0x4c, 0x12, 0xaa, 0x7c, 0x19, 0xa6, 0xe2, 0xb7, 0xb4,
-	0x57, 0x67, 0x0b, 0x45, 0x6b, 0xa8, 0x06, 0xd8, 0x0f, 0x33, 0xd1, 0x72, 0x6c, 0x8d, 0x6b, 0x25,
-	0x1d, 0x7a, 0x9a, 0xd9, 0x7d, 0xcf, 0x00, 0xb3, 0xe9, 0x49, 0xfd, 0xeb, 0xa6, 0x05, 0xc9, 0xcc,
-	0x4a, 0xe5, 0x1b, 0xc4, 0xc9, 0xcd, 0xea, 0x3a, 0x29, 0x59, 0x77, 0xbb, 0xee, 0x76, 0xae, 0x13,
-	0xfa, 0xc1, 0x86, 0xda, 0x89, 0x4c, 0x3b, 0x6b, 0x71, 0x58, 0xfc, 0xe8, 0xfe, 0x4e, 0xe4, 0xd9,
-	0xff, 0x30, 0x38, 0x9b, 0x8b, 0xa3, 0x12, 0xde, 0x85, 0xd9, 0xbe, 0x50, 0x41, 0xe8, 0x6f, 0x18,
-	0x30, 0x4d, 0xe2, 0xe5, 0xc3, 0xa5, 0x04, 0xa1, 0x4f, 0xb1, 0x67, 0xfa, 0x99, 0x13, 0xde, 0x85,
-	0x39, 0xfa, 0x58, 0x12, 0x0a, 0x53, 0xdd, 0x85, 0x02, 0xc5, 0xfb, 0x06, 0x44, 0x1c, 0xb3, 0xed,
-	0xec, 0x11, 0x6f, 0xc3, 0x19, 0xe5, 0x76, 0x3a, 0x3b, 0x09, 0xc5, 0xa4, 0xa6, 0xb0, 0x0a, 0x14,
-	0xf7, 0x63, 0x08, 0x11, 0xcc, 0xa8, 0xc1, 0xc1, 0x0e, 0xa9, 0x38, 0xca, 0x31, 0xf6, 0x7e, 0xe4,
-	0x3c, 0xa1, 0x32, 0xb6, 0x27, 0xd8, 0x1f, 0x91, 0xc9, 0xa6, 0xf9, 0xa8, 0x9b, 0x6f, 0xc1, 0x69,
-	0x02, 0x51, 0x1f, 0xcf, 0x97, 0x37, 0xa1, 0x95, 0xc0, 0xec, 0x6f, 0xf2, 0x4c, 0xcf, 0x7e, 0xb5,
-	0x1f, 0x33, 0x32, 0xea, 0x81, 0x02, 0x2a, 0x66, 0x15, 0xaa, 0xa4, 0x32, 0x59, 0xf0, 0x61, 0xd5,
-	0xa4, 0xb8, 0x93, 0x5b, 0xf3, 0xb7, 0x61, 0x5e, 0xab, 0xd2, 0x23, 0x6f, 0x79, 0xb2, 0xd7, 0x51,
-	0xc7, 0xb8, 0xc9, 0xea, 0x87, 0x63, 0xd3, 0x09, 0x4d, 0xeb, 0xc5, 0xa1, 0xf9, 0x94, 0x6e, 0x18,
-	0x85, 0x18, 0xe0, 0xea, 0xdf, 0x55, 0x98, 0xd6, 0x74, 0xf8, 0x2d, 0x83, 0x6a, 0xe2, 0xc3, 0xb8,
-	0x58, 0x88, 0x2c, 0xbb, 0x74, 0xad, 0xd7, 0x46, 0x83, 0x8c, 0x26, 0xdb, 0x79, 0xf4, 0xe7, 0x7f,
-	0x3f, 0x57, 0x56, 0x70, 0x89, 0xe7, 0xef, 0xfb, 0xd4, 0xe9, 0xf9, 0x6e, 0xa6, 0xe0, 0x3d, 0xfc,
-	0x1a, 0x6a, 0xe9, 0x1d, 0x82, 0x23, 0x53, 0x24, 0xeb, 0x64, 0x5d, 0x3a, 0x02, 0x45, 0x4a, 0x16,
-	0xb4, 0x12, 0x0b, 0xeb, 0xc3, 0x94, 0xe0, 0x77, 0x0c, 0xa6, 0x62, 0x5f, 0xc3, 0x8b, 0x65, 0x8c,
-	0x99, 0x0b, 0xc4, 0x5a, 0x18, 0x0e, 0xa0, 0x6c, 0xb7, 0x74, 0xb6, 0x1b, 0x78, 0x6d, 0xbc, 0xba,
-	0xb9, 0x76, 0x52, 0xbe, 0xab, 0xaf, 0x93, 0x3d, 0x7c, 0xc4, 0x60, 0x5a, 0xdb, 0x31, 0x0e, 0xcd,
-	0x94, 0x96, 0xff, 0xea, 0x08, 0x04, 0x89, 0xb9, 0xa6, 0xc5, 0x38, 0xf8, 0xe6, 0x71, 0xc4, 0xe0,
-	0x43, 0x38, 0x45, 0x0e, 0x56, 0x9a, 0x22, 0x67, 0xd2, 0x96, 0x3d, 0x0a, 0x42, 0x32, 0x2e, 0x6b,
-	0x19, 0x97, 0x70, 0xb1, 0x28, 0x43, 0xc3, 0xf8, 0x6e, 0xc6, 0xe5, 0xf7, 0xf0, 0x17, 0x06, 0xa7,
-	0xe9, 0x1b, 0xc4, 0x52, 0xf2, 0xbc, 0x1f, 0x5a, 0x8b, 0x23, 0x31, 0xa4, 0xe0, 0xae, 0x56, 0x70,
-	0x1b, 0xdf, 0x19, 0xb3, 0x11, 0xc9, 0xb7, 0xcf, 0x77, 0x53, 0x7f, 0xdc, 0xc3, 0x1f, 0x18, 0x54,
-	0x13, 0x43, 0xc1, 0x51, 0x69, 0xe5, 0xc8, 0x4f, 0xa5, 0xe8, 0x49, 0xf6, 0x4d, 0x2d, 0xae, 0x89,
-	0xfc, 0x98, 0xe2, 0xf0, 0x31, 0x83, 0x99, 0xcc, 0xc7, 0x8d, 0x4b, 0x65, 0xe9, 0x0e, 0x9b, 0x8d,
-	0xb5, 0x7c, 0x24, 0xee, 0x29, 0xf7, 0x47, 0x9b, 0xcb, 0x9d, 0x0f, 0x7e, 0xdf, 0x6f, 0xb0, 0x27,
-	0xfb, 0x0d, 0xf6, 0xef, 0x7e, 0x83, 0xfd, 0x74, 0xd0, 0x98, 0x78, 0x72, 0xd0, 0x98, 0xf8, 0xeb,
-	0xa0, 0x31, 0xf1, 0xf9, 0x65, 0x3f, 0x50, 0x5f, 0xf4, 0x36, 0x9d, 0x2d, 0xb1, 0x9d, 0x30, 0x9a,
-	0x3f, 0x57, 0x64, 0xfb, 0x4b, 0xfe, 0x95, 0xa6, 0x8f, 0xb7, 0x40, 0xc6, 0x3f, 0x2e, 0x4e, 0xe9,
-	0xff, 0xfd, 0xaf, 0xfe, 0x1f, 0x00, 0x00, 0xff, 0xff, 0xce, 0x57, 0x85, 0xcf, 0xa5, 0x0c, 0x00,
-	0x00,
+	// 1027 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xdf, 0x6f, 0xdb, 0x54,
+	0x14, 0xee, 0xcd, 0xd2, 0xae, 0x39, 0x5d, 0x0b, 0x9c, 0x75, 0x6b, 0x30, 0x23, 0xcd, 0x5c, 0xd6,
+	0x16, 0x46, 0x6d, 0xd2, 0xfd, 0x92, 0x60, 0x13, 0xd0, 0x41, 0x01, 0x09, 0xa4, 0x92, 0x4d, 0x3c,
+	0xf0, 0x12, 0xb9, 0x8d, 0x65, 0x2c, 0x52, 0x5f, 0xcf, 0xd7, 0x89, 0x28, 0x5d, 0x85, 0x34, 0x09,
+	0xc1, 0x13, 0x20, 0x31, 0x09, 0x1e, 0xf9, 0x23, 0xf8, 0x23, 0xf6, 0x38, 0xc1, 0x0b, 0x12, 0x12,
+	0x42, 0x2d, 0xff, 0x06, 0x12, 0xf2, 0xbd, 0xc7, 0xae, 0xed, 0x38, 0x3f, 0x3a, 0x4d, 0x3c, 0x25,
+	0xbe, 0xf7, 0x3b, 0xdf, 0xf9, 0xce, 0x77, 0x8f, 0xcf, 0x4d, 0xe0, 0xf9, 0x1d, 0x2e, 0x76, 0xb9,
+	0x30, 0x1d, 0xde, 0x33, 0x7b, 0x0d, 0xf3, 0x5e, 0xd7, 0x0e, 0xf6, 0x0c, 0x3f, 0xe0, 0x21, 0xc7,
+	0x59, 0xb5, 0x65, 0x38, 0xbc, 0x67, 0xf4, 0x1a, 0xda, 0x2b, 0x84, 0xdc, 0xb6, 0x84, 0xad, 0x70,
+	0x66, 0xaf, 0xb1, 0x6d, 0x87, 0x56, 0xc3, 0xf4, 0x2d, 0xc7, 0xf5, 0xac, 0xd0, 0xe5, 0x9e, 0x0a,
+	0xd5, 0x2e, 0x38, 0x9c, 0x3b, 0x1d, 0xdb, 0xb4, 0x7c, 0xd7, 0xb4, 0x3c, 0x8f, 0x87, 0x72, 0x53,
+	0xd0, 0xee, 0x42, 0x36, 0x67, 0xc4, 0xaf, 0x36, 0x48, 0x4c, 0x4b, 0x3e, 0x99, 0x94, 0x5e, 0x6d,
+	0xcd, 0x3b, 0xdc, 0xe1, 0x6a, 0x3d, 0xfa, 0xa6, 0x56, 0xf5, 0x1b, 0x30, 0xff, 0x71, 0xa4, 0x64,
+	0x2b, 0xe0, 0x3e, 0x17, 0x56, 0xa7, 0x69, 0xdf, 0xeb, 0xda, 0x22, 0xc4, 0x45, 0x98, 0xf1, 0x69,
+	0xa9, 0xe5, 0xb6, 0xab, 0xac, 0xce, 0x56, 0xcb, 0x4d, 0x88, 0x97, 0x3e, 0x68, 0xeb, 0x1f, 0xc2,
+	0xb9, 0x5c, 0xa0, 0xf0, 0xb9, 0x27, 0x6c, 0xbc, 0x02, 0xd3, 0x31, 0x4c, 0x86, 0xcd, 0xac, 0x2f,
+	0x18, 0x19, 0x1f, 0x8c, 0x24, 0x24, 0x01, 0xea, 0xdf, 0x97, 0x72, 0x74, 0x22, 0x16, 0xb2, 0x09,
+	0xcf, 0x24, 0x42, 0x44, 0x68, 0x85, 0x5d, 0x21, 0x59, 0xe7, 0xd6, 0x5f, 0x1c, 0xc0, 0x7a, 0x47,
+	0x82, 0x9a, 0x73, 0x7e, 0xe6, 0x19, 0x0d, 0x98, 0xec, 0xf1, 0xd0, 0x0e, 0xaa, 0xa5, 0x3a, 0x5b,
+	0xad, 0x6c, 0x54, 0x7f, 0xfb, 0x75, 0x6d, 0x9e, 0x08, 0xde, 0x6e, 0xb7, 0x03, 0x5b, 0x88, 0x3b,
+	0x61, 0xe0, 0x7a, 0x4e, 0x53, 0xc1, 0xf0, 0x3a, 0x54, 0xda, 0xb6, 0xcf, 0x85, 0x1b, 0xf2, 0xa0,
+	0x7a, 0x6a, 0x44, 0xcc, 0x31, 0x14, 0x37, 0x01, 0x8e, 0x0f, 0xb3, 0x5a, 0x96, 0x06, 0x2c, 0xc7,
+	0x52, 0xa3, 0x93, 0x37, 0x54, 0x87, 0xd0, 0xc9, 0x1b, 0x5b, 0x96, 0x63, 0x53, 0xad, 0xcd, 0x54,
+	0xa4, 0xfe, 0x33, 0x83, 0xf3, 0x79, 0x47, 0xc8, 0xe1, 0x6b, 0x50, 0x89, 0x8b, 0x8b, 0xcc, 0x38,
+	0x35, 0xcc, 0xe2, 0x63, 0x24, 0xbe, 0x97, 0x51, 0x56, 0x92, 0xca, 0x56, 0x46, 0x2a, 0x53, 0x39,
+	0x33, 0xd2, 0x76, 0xe0, 0x59, 0xa9, 0xec, 0x13, 0x1e, 0xda, 0xe3, 0xf6, 0xcb, 0x49, 0xfd, 0xd7,
+	0x6f, 0xc2, 0x73, 0xa9, 0x24, 0x54, 0xf9, 0x0a, 0x94, 0xa3, 0x5d, 0xea, 0xab, 0xb3, 0xb9, 0xa2,
+	0x25, 0x54, 0x02, 0xf4, 0xfb, 0xa9, 0x68, 0x31, 0xb6, 0xc6, 0xcd, 0x02, 0x87, 0x9e, 0xe4, 0xec,
+	0xbe, 0x65, 0x80, 0xe9, 0xf4, 0xa4, 0xfe, 0x65, 0x65, 0x41, 0x7c, 0x66, 0x85, 0xf2, 0x15, 0xe2,
+	0xe9, 0x9d, 0xd5, 0x35, 0x52, 0xb2, 0x65, 0x05, 0xd6, 0x6e, 0xc6, 0x09, 0xb9, 0xd0, 0x0a, 0xf7,
+	0x7c, 0x65, 0x67, 0xa5, 0x09, 0x6a, 0xe9, 0xee, 0x9e, 0x6f, 0xeb, 0x7f, 0x32, 0x38, 0x9b, 0x89,
+	0xa3, 0x12, 0xde, 0x82, 0xd9, 0x1e, 0x0f, 0x5d, 0xcf, 0x69, 0x29, 0x30, 0x9d, 0xc4, 0x0b, 0xfd,
+	0xa5, 0xb8, 0x9e, 0x43, 0xb1, 0x67, 0x7a, 0xa9, 0x27, 0xbc, 0x0d, 0x73, 0xf4, 0xb2, 0xc4, 0x14,
+	0xaa, 0xba, 0x0b, 0x39, 0x8a, 0x77, 0x14, 0x88, 0x38, 0x66, 0xdb, 0xe9, 0x47, 0xbc, 0x05, 0x67,
+	0x42, 0xab, 0xd3, 0xd9, 0x8b, 0x29, 0x4e, 0x49, 0x0a, 0x2d, 0x47, 0x71, 0x37, 0x82, 0x10, 0xc1,
+	0x4c, 0x78, 0xfc, 0xa0, 0x7b, 0x54, 0x1c, 0xe5, 0x18, 0xbb, 0x3f, 0x32, 0x33, 0xa1, 0x34, 0xf6,
+	0x4c, 0xd0, 0xdf, 0x87, 0xf9, 0x6c, 0x3e, 0x72, 0xf3, 0x35, 0x38, 0x4d, 0x20, 0xf2, 0xf1, 0x7c,
+	0xb1, 0x09, 0xcd, 0x18, 0xa6, 0x7f, 0x95, 0x65, 0xfa, 0xff, 0x5b, 0xfb, 0x21, 0x83, 0x73, 0x39,
+	0x05, 0x54, 0xcc, 0x3a, 0x4c, 0x93, 0xca, 0xb8, 0xc1, 0x07, 0x55, 0x93, 0xe0, 0x9e, 0x5e, 0x9b,
+	0xbf, 0x0e, 0x0b, 0x52, 0x95, 0x3c, 0xf2, 0xa6, 0x2d, 0xba, 0x9d, 0xf0, 0x04, 0x37, 0x59, 0xb5,
+	0x3f, 0x36, 0x39, 0xa1, 0x49, 0xd9, 0x38, 0x55, 0x36, 0xb8, 0xc3, 0x28, 0x44, 0x01, 0xf5, 0x3a,
+	0xd4, 0x24, 0xdb, 0x47, 0xb6, 0x10, 0x96, 0x63, 0x6f, 0x58, 0xc2, 0x6e, 0x67, 0x5e, 0x3e, 0x7d,
+	0x1b, 0x16, 0x07, 0x22, 0x28, 0xed, 0x9b, 0x30, 0x95, 0xbc, 0x5f, 0x91, 0x93, 0x17, 0x73, 0x79,
+	0xfb, 0x43, 0x37, 0xca, 0x8f, 0xfe, 0x5a, 0x9c, 0x68, 0x52, 0xd8, 0xfa, 0xbf, 0x15, 0x98, 0x94,
+	0x49, 0xf0, 0x6b, 0x06, 0xd3, 0xf1, 0x6d, 0x80, 0x4b, 0x39, 0x9e, 0xa2, 0xab, 0x5f, 0x7b, 0x69,
+	0x38, 0x48, 0x49, 0xd4, 0x8d, 0x07, 0xbf, 0xff, 0xf3, 0x63, 0x69, 0x15, 0x97, 0xcd, 0xec, 0x6f,
+	0x91, 0xe4, 0xbe, 0x31, 0xf7, 0x53, 0xb6, 0x1f, 0xe0, 0x97, 0x50, 0x89, 0x39, 0x04, 0x0e, 0x4d,
+	0x11, 0x1b, 0xa5, 0x5d, 0x1a, 0x81, 0x22, 0x25, 0x75, 0xa9, 0x44, 0xc3, 0xea, 0x20, 0x25, 0xf8,
+	0x0d, 0x83, 0x72, 0x34, 0x5d, 0x71, 0xb1, 0x88, 0x31, 0x75, 0x8d, 0x69, 0xf5, 0xc1, 0x00, 0xca,
+	0x76, 0x53, 0x66, 0xbb, 0x8e, 0x57, 0xc7, 0xab, 0xdb, 0x94, 0xf3, 0xdc, 0xdc, 0x8f, 0x3e, 0x82,
+	0x03, 0x7c, 0xc0, 0x60, 0x32, 0xa2, 0x13, 0x38, 0x30, 0x53, 0x52, 0xfe, 0xc5, 0x21, 0x08, 0x12,
+	0x73, 0x55, 0x8a, 0x31, 0xf0, 0xd5, 0x93, 0x88, 0xc1, 0xfb, 0x30, 0x45, 0x73, 0xb4, 0x30, 0x45,
+	0xa6, 0x5b, 0x35, 0x7d, 0x18, 0x84, 0x64, 0x5c, 0x96, 0x32, 0x2e, 0xe1, 0x52, 0x5e, 0x86, 0x84,
+	0x99, 0xfb, 0xa9, 0xbb, 0xe6, 0x00, 0x7f, 0x62, 0x70, 0x9a, 0x26, 0x01, 0x16, 0x92, 0x67, 0xa7,
+	0xb2, 0xb6, 0x34, 0x14, 0x43, 0x0a, 0x6e, 0x4b, 0x05, 0xb7, 0xf0, 0x8d, 0x31, 0x8d, 0x88, 0x27,
+	0x90, 0xb9, 0x9f, 0x4c, 0xe9, 0x03, 0xfc, 0x8e, 0xc1, 0x34, 0x11, 0x0b, 0x1c, 0x96, 0x56, 0x0c,
+	0x7d, 0x55, 0xf2, 0x93, 0x51, 0xbf, 0x21, 0xc5, 0x35, 0xd0, 0x3c, 0xa1, 0x38, 0x7c, 0xc8, 0x60,
+	0x26, 0x35, 0x62, 0x70, 0xb9, 0x28, 0x5d, 0xff, 0xc8, 0xd3, 0x56, 0x46, 0xe2, 0x9e, 0xb0, 0x7f,
+	0xe4, 0x88, 0xc3, 0x5f, 0x18, 0x60, 0xff, 0x04, 0xc2, 0xb5, 0xa2, 0xac, 0x03, 0xc7, 0xa0, 0x66,
+	0x8c, 0x0b, 0x1f, 0xd1, 0x64, 0xbb, 0x2a, 0xa4, 0x15, 0x5d, 0x17, 0x6d, 0xfa, 0x41, 0xb0, 0xf1,
+	0xee, 0xa3, 0xc3, 0x1a, 0x7b, 0x7c, 0x58, 0x63, 0x7f, 0x1f, 0xd6, 0xd8, 0x0f, 0x47, 0xb5, 0x89,
+	0xc7, 0x47, 0xb5, 0x89, 0x3f, 0x8e, 0x6a, 0x13, 0x9f, 0x5e, 0x76, 0xdc, 0xf0, 0xb3, 0xee, 0xb6,
+	0xb1, 0xc3, 0x77, 0x63, 0x22, 0xf5, 0xb1, 0x26, 0xda, 0x9f, 0x9b, 0x5f, 0x48, 0xd6, 0xa8, 0x51,
+	0x45, 0xf4, 0xdf, 0x6c, 0x4a, 0xfe, 0x49, 0xba, 0xf2, 0xdf, 0x00, 0x53, 0xae, 0xf8, 0xa8, 0xe4,
+	0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -986,6 +1079,9 @@ type QueryClient interface {
 	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// MessageBasedParams queries the params overriding the voting and tally
+	// params for the proposals containing given message types.
+	MessageBasedParams(ctx context.Context, in *QueryMessageBasedParamsRequest, opts ...grpc.CallOption) (*QueryMessageBasedParamsResponse, error)
 }
 
 type queryClient struct {
@@ -1068,6 +1164,15 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) MessageBasedParams(ctx context.Context, in *QueryMessageBasedParamsRequest, opts ...grpc.CallOption) (*QueryMessageBasedParamsResponse, error) {
+	out := new(QueryMessageBasedParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Query/MessageBasedParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Proposal queries proposal details based on ProposalID.
@@ -1086,6 +1191,9 @@ type QueryServer interface {
 	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// MessageBasedParams queries the params overriding the voting and tally
+	// params for the proposals containing given message types.
+	MessageBasedParams(context.Context, *QueryMessageBasedParamsRequest) (*QueryMessageBasedParamsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1116,6 +1224,9 @@ func (*UnimplementedQueryServer) Deposits(ctx context.Context, req *QueryDeposit
 func (*UnimplementedQueryServer) TallyResult(ctx context.Context, req *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (*UnimplementedQueryServer) MessageBasedParams(ctx context.Context, req *QueryMessageBasedParamsRequest) (*QueryMessageBasedParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MessageBasedParams not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1265,6 +1376,24 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_MessageBasedParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMessageBasedParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MessageBasedParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Query/MessageBasedParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MessageBasedParams(ctx, req.(*QueryMessageBasedParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.gov.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1301,6 +1430,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
 		},
+		{
+			MethodName: "MessageBasedParams",
+			Handler:    _Query_MessageBasedParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/query.proto",
@@ -1942,6 +2075,66 @@ func (m *QueryTallyResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryMessageBasedParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMessageBasedParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMessageBasedParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMessageBasedParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMessageBasedParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMessageBasedParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Params) > 0 {
+		for iNdEx := len(m.Params) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Params[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2208,6 +2401,30 @@ func (m *QueryTallyResultResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryMessageBasedParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMessageBasedParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Params) > 0 {
+		for _, e := range m.Params {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3877,6 +4094,140 @@ func (m *QueryTallyResultResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryMessageBasedParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMessageBasedParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMessageBasedParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMessageBasedParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMessageBasedParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMessageBasedParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Params = append(m.Params, MessageBasedParams{})
+			if err := m.Params[len(m.Params)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
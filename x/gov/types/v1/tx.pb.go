@@ -40,6 +40,8 @@ type MsgSubmitProposal struct {
 	Proposer       string        `protobuf:"bytes,3,opt,name=proposer,proto3" json:"proposer,omitempty"`
 	// metadata is any arbitrary metadata attached to the proposal.
 	Metadata string `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// expedited defines if the proposal is expedited.
+	Expedited bool `protobuf:"varint,5,opt,name=expedited,proto3" json:"expedited,omitempty"`
 }
 
 func (m *MsgSubmitProposal) Reset()         { *m = MsgSubmitProposal{} }
@@ -103,6 +105,13 @@ func (m *MsgSubmitProposal) GetMetadata() string {
 	return ""
 }
 
+func (m *MsgSubmitProposal) GetExpedited() bool {
+	if m != nil {
+		return m.Expedited
+	}
+	return false
+}
+
 // MsgSubmitProposalResponse defines the Msg/SubmitProposal response type.
 type MsgSubmitProposalResponse struct {
 	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
@@ -567,53 +576,54 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1/tx.proto", fileDescriptor_9ff8f4a63b6fc9a9) }
 
 var fileDescriptor_9ff8f4a63b6fc9a9 = []byte{
-	// 734 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x95, 0xcf, 0x6a, 0xdb, 0x4a,
-	0x14, 0xc6, 0xad, 0xd8, 0x37, 0x4e, 0x4e, 0x6e, 0x1c, 0x32, 0x98, 0x44, 0x16, 0x41, 0x71, 0x7c,
-	0xe1, 0x62, 0x6e, 0x88, 0x14, 0xe7, 0x96, 0x16, 0x92, 0x52, 0x88, 0xd3, 0xd0, 0x16, 0x6a, 0x5a,
-	0x14, 0x48, 0xa1, 0x14, 0x82, 0x6c, 0x4d, 0x27, 0xa2, 0x91, 0x46, 0x68, 0xc6, 0x26, 0x5e, 0xb6,
-	0x4f, 0xd0, 0xf7, 0xe8, 0xa6, 0x8b, 0xec, 0xbb, 0x2b, 0xa1, 0xab, 0xd0, 0x55, 0x56, 0xa1, 0x24,
-	0x8b, 0x42, 0x57, 0x7d, 0x84, 0x22, 0xcd, 0x48, 0x4e, 0xac, 0xfc, 0xeb, 0xa6, 0x2b, 0x4b, 0xe7,
-	0x7c, 0xdf, 0x99, 0xf3, 0xd3, 0xcc, 0xf1, 0xc0, 0x4c, 0x87, 0x32, 0x8f, 0x32, 0x93, 0xd0, 0x9e,
-	0xd9, 0x6b, 0x98, 0x7c, 0xdf, 0x08, 0x42, 0xca, 0x29, 0x9a, 0x14, 0x71, 0x83, 0xd0, 0x9e, 0xd1,
-	0x6b, 0x68, 0xba, 0x94, 0xb5, 0x6d, 0x86, 0xcd, 0x5e, 0xa3, 0x8d, 0xb9, 0xdd, 0x30, 0x3b, 0xd4,
-	0xf5, 0x85, 0x5c, 0x9b, 0xbd, 0x58, 0x26, 0x72, 0x89, 0x44, 0x99, 0x50, 0x42, 0xe3, 0x47, 0x33,
-	0x7a, 0x92, 0xd1, 0x8a, 0x90, 0xef, 0x88, 0x84, 0x5c, 0x4a, 0xa6, 0x08, 0xa5, 0x64, 0x0f, 0x9b,
-	0xf1, 0x5b, 0xbb, 0xfb, 0xda, 0xb4, 0xfd, 0xfe, 0xd0, 0x22, 0x1e, 0x23, 0xd1, 0x22, 0x1e, 0x23,
-	0x22, 0x51, 0xfb, 0xa9, 0xc0, 0x74, 0x8b, 0x91, 0xad, 0x6e, 0xdb, 0x73, 0xf9, 0xf3, 0x90, 0x06,
-	0x94, 0xd9, 0x7b, 0x68, 0x19, 0xc6, 0x3c, 0xcc, 0x98, 0x4d, 0x30, 0x53, 0x95, 0x6a, 0xbe, 0x3e,
-	0xb1, 0x52, 0x36, 0x44, 0x71, 0x23, 0x29, 0x6e, 0xac, 0xfb, 0x7d, 0x2b, 0x55, 0xa1, 0xc7, 0x30,
-	0xe5, 0xfa, 0x2e, 0x77, 0xed, 0xbd, 0x1d, 0x07, 0x07, 0x94, 0xb9, 0x5c, 0x1d, 0x89, 0x8d, 0x15,
-	0x43, 0xf6, 0x18, 0xf1, 0x1b, 0x92, 0xdf, 0xd8, 0xa0, 0xae, 0xdf, 0x2c, 0x1c, 0x9e, 0xcc, 0xe7,
-	0xac, 0x92, 0xf4, 0x3d, 0x14, 0x36, 0x74, 0x07, 0xc6, 0x82, 0xb8, 0x0f, 0x1c, 0xaa, 0xf9, 0xaa,
-	0x52, 0x1f, 0x6f, 0xaa, 0x5f, 0x0f, 0x96, 0xca, 0xb2, 0xca, 0xba, 0xe3, 0x84, 0x98, 0xb1, 0x2d,
-	0x1e, 0xba, 0x3e, 0xb1, 0x52, 0x25, 0xd2, 0xa2, 0x8e, 0xb9, 0xed, 0xd8, 0xdc, 0x56, 0x0b, 0x91,
-	0xcb, 0x4a, 0xdf, 0x57, 0x27, 0xdf, 0x7d, 0xff, 0xf8, 0x5f, 0x2a, 0xad, 0xdd, 0x87, 0x4a, 0x86,
-	0xd8, 0xc2, 0x2c, 0xa0, 0x3e, 0xc3, 0x68, 0x1e, 0x26, 0x02, 0x19, 0xdb, 0x71, 0x1d, 0x55, 0xa9,
-	0x2a, 0xf5, 0x82, 0x05, 0x49, 0xe8, 0x89, 0x53, 0x7b, 0xab, 0x40, 0xb9, 0xc5, 0xc8, 0xe6, 0x3e,
-	0xee, 0x3c, 0xc5, 0xc4, 0xee, 0xf4, 0x37, 0xa8, 0xcf, 0xb1, 0xcf, 0xd1, 0x1a, 0x14, 0x3b, 0xe2,
-	0x31, 0x76, 0x5d, 0xf1, 0xc9, 0x9a, 0x13, 0x5f, 0x0e, 0x96, 0x8a, 0xd2, 0x63, 0x25, 0x0e, 0x34,
-	0x07, 0xe3, 0x76, 0x97, 0xef, 0xd2, 0xd0, 0xe5, 0x7d, 0x75, 0x24, 0xee, 0x7f, 0x10, 0x58, 0x2d,
-	0x45, 0x00, 0x83, 0xf7, 0x9a, 0x0e, 0x73, 0x97, 0xb5, 0x90, 0x40, 0xd4, 0x3e, 0x2b, 0x50, 0x6c,
-	0x31, 0xb2, 0x4d, 0x39, 0x46, 0xcb, 0x97, 0x00, 0x35, 0xa7, 0x7e, 0x9c, 0xcc, 0x9f, 0x0f, 0x9f,
-	0x27, 0x44, 0x06, 0xfc, 0xd5, 0xa3, 0x1c, 0x87, 0xa2, 0x8f, 0x6b, 0xbe, 0xbe, 0x90, 0xa1, 0x06,
-	0x8c, 0xd2, 0x80, 0xbb, 0xd4, 0x8f, 0xb7, 0xab, 0x34, 0xd8, 0x71, 0x31, 0x00, 0x46, 0xd4, 0xc6,
-	0xb3, 0x58, 0x60, 0x49, 0xe1, 0xb5, 0xbb, 0x05, 0x11, 0xac, 0x28, 0x5d, 0x9b, 0x86, 0x29, 0xc9,
-	0x91, 0xb2, 0x1d, 0x2b, 0x69, 0xec, 0x05, 0x76, 0xc9, 0x2e, 0xc7, 0xce, 0x1f, 0x60, 0x5c, 0x83,
-	0xa2, 0x68, 0x9d, 0xa9, 0xf9, 0xf8, 0x58, 0x2f, 0x0c, 0x41, 0x26, 0xbd, 0x9c, 0x83, 0x4d, 0x1c,
-	0xb7, 0xa6, 0xad, 0xc0, 0xec, 0x10, 0x59, 0x4a, 0xfd, 0x49, 0x01, 0x68, 0x31, 0x92, 0xcc, 0xc8,
-	0xef, 0x03, 0xdf, 0x85, 0x71, 0x39, 0x97, 0xf4, 0x66, 0xe8, 0x81, 0x14, 0xdd, 0x83, 0x51, 0xdb,
-	0xa3, 0x5d, 0x9f, 0x4b, 0xee, 0x1b, 0xc7, 0x59, 0xca, 0xe5, 0x99, 0x4d, 0x0b, 0xd5, 0xca, 0x80,
-	0x06, 0x00, 0x09, 0xd7, 0xca, 0x87, 0x3c, 0xe4, 0x5b, 0x8c, 0xa0, 0x57, 0x50, 0x1a, 0xfa, 0x0b,
-	0xaa, 0x0e, 0x7d, 0xe0, 0xcc, 0xc8, 0x6a, 0xf5, 0x9b, 0x14, 0xe9, 0x50, 0x63, 0x98, 0xce, 0xce,
-	0xeb, 0x3f, 0x59, 0x7b, 0x46, 0xa4, 0x2d, 0xde, 0x42, 0x94, 0x2e, 0xf3, 0x00, 0x0a, 0xf1, 0xc8,
-	0xcd, 0x64, 0x4d, 0x51, 0x5c, 0xd3, 0x2f, 0x8f, 0xa7, 0xfe, 0x6d, 0xf8, 0xfb, 0xc2, 0xb1, 0xbe,
-	0x42, 0x9f, 0xe4, 0xb5, 0x7f, 0xaf, 0xcf, 0xa7, 0x75, 0x1f, 0x41, 0x31, 0x39, 0x38, 0x95, 0xac,
-	0x45, 0xa6, 0xb4, 0x85, 0x2b, 0x53, 0x49, 0xa1, 0xe6, 0xe6, 0xe1, 0xa9, 0xae, 0x1c, 0x9d, 0xea,
-	0xca, 0xb7, 0x53, 0x5d, 0x79, 0x7f, 0xa6, 0xe7, 0x8e, 0xce, 0xf4, 0xdc, 0xf1, 0x99, 0x9e, 0x7b,
-	0xb9, 0x48, 0x5c, 0xbe, 0xdb, 0x6d, 0x1b, 0x1d, 0xea, 0xc9, 0x3b, 0x49, 0xfe, 0x2c, 0x31, 0xe7,
-	0x8d, 0xb9, 0x1f, 0x5f, 0x6e, 0xbc, 0x1f, 0x60, 0x16, 0xdd, 0x80, 0xa3, 0xf1, 0x1f, 0xe2, 0xff,
-	0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0xb7, 0xdb, 0x52, 0x42, 0x41, 0x07, 0x00, 0x00,
+	// 747 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x95, 0x41, 0x4b, 0x1b, 0x4d,
+	0x18, 0xc7, 0xb3, 0x49, 0x34, 0xfa, 0xf8, 0x1a, 0x71, 0x08, 0xba, 0x59, 0x64, 0x8d, 0x79, 0xe1,
+	0x25, 0xbc, 0xe2, 0xae, 0xb1, 0xa5, 0x05, 0x2d, 0x05, 0x63, 0xa5, 0x2d, 0x34, 0xb4, 0xac, 0x60,
+	0xa1, 0x14, 0x64, 0x93, 0x9d, 0x8e, 0x43, 0xcd, 0xce, 0x92, 0x99, 0x84, 0xe4, 0xd8, 0x7e, 0x80,
+	0xd2, 0xef, 0xd1, 0x4b, 0x0f, 0xde, 0x7b, 0x2b, 0xd2, 0x93, 0xf4, 0xe4, 0x49, 0x8a, 0x1e, 0x0a,
+	0xfd, 0x14, 0x65, 0x77, 0x67, 0x37, 0x9a, 0x55, 0x63, 0x2f, 0x3d, 0x65, 0xf7, 0x79, 0xfe, 0xff,
+	0x67, 0x9e, 0xdf, 0xce, 0x3c, 0x19, 0x98, 0x6b, 0x32, 0xde, 0x62, 0xdc, 0x24, 0xac, 0x6b, 0x76,
+	0xab, 0xa6, 0xe8, 0x19, 0x5e, 0x9b, 0x09, 0x86, 0xa6, 0xc3, 0xb8, 0x41, 0x58, 0xd7, 0xe8, 0x56,
+	0x35, 0x5d, 0xca, 0x1a, 0x36, 0xc7, 0x66, 0xb7, 0xda, 0xc0, 0xc2, 0xae, 0x9a, 0x4d, 0x46, 0xdd,
+	0x50, 0xae, 0xcd, 0x5f, 0x2e, 0xe3, 0xbb, 0xc2, 0x44, 0x81, 0x30, 0xc2, 0x82, 0x47, 0xd3, 0x7f,
+	0x92, 0xd1, 0x62, 0x28, 0xdf, 0x0b, 0x13, 0x72, 0x29, 0x99, 0x22, 0x8c, 0x91, 0x03, 0x6c, 0x06,
+	0x6f, 0x8d, 0xce, 0x1b, 0xd3, 0x76, 0xfb, 0x43, 0x8b, 0xb4, 0x38, 0xf1, 0x17, 0x69, 0x71, 0x12,
+	0x26, 0xca, 0x1f, 0xd2, 0x30, 0x5b, 0xe7, 0x64, 0xa7, 0xd3, 0x68, 0x51, 0xf1, 0xa2, 0xcd, 0x3c,
+	0xc6, 0xed, 0x03, 0xb4, 0x0a, 0x13, 0x2d, 0xcc, 0xb9, 0x4d, 0x30, 0x57, 0x95, 0x52, 0xa6, 0x32,
+	0xb5, 0x56, 0x30, 0xc2, 0xe2, 0x46, 0x54, 0xdc, 0xd8, 0x74, 0xfb, 0x56, 0xac, 0x42, 0x4f, 0x60,
+	0x86, 0xba, 0x54, 0x50, 0xfb, 0x60, 0xcf, 0xc1, 0x1e, 0xe3, 0x54, 0xa8, 0xe9, 0xc0, 0x58, 0x34,
+	0x64, 0x8f, 0x3e, 0xbf, 0x21, 0xf9, 0x8d, 0x2d, 0x46, 0xdd, 0x5a, 0xf6, 0xe8, 0x74, 0x31, 0x65,
+	0xe5, 0xa5, 0xef, 0x51, 0x68, 0x43, 0x77, 0x61, 0xc2, 0x0b, 0xfa, 0xc0, 0x6d, 0x35, 0x53, 0x52,
+	0x2a, 0x93, 0x35, 0xf5, 0xfb, 0xe1, 0x4a, 0x41, 0x56, 0xd9, 0x74, 0x9c, 0x36, 0xe6, 0x7c, 0x47,
+	0xb4, 0xa9, 0x4b, 0xac, 0x58, 0x89, 0x34, 0xbf, 0x63, 0x61, 0x3b, 0xb6, 0xb0, 0xd5, 0xac, 0xef,
+	0xb2, 0xe2, 0x77, 0xb4, 0x00, 0x93, 0xb8, 0xe7, 0x61, 0x87, 0x0a, 0xec, 0xa8, 0x63, 0x25, 0xa5,
+	0x32, 0x61, 0x0d, 0x02, 0xeb, 0xd3, 0xef, 0x7f, 0x7e, 0xfe, 0x3f, 0x2e, 0x54, 0x7e, 0x00, 0xc5,
+	0xc4, 0xf7, 0xb0, 0x30, 0xf7, 0x98, 0xcb, 0x31, 0x5a, 0x84, 0x29, 0x4f, 0xc6, 0xf6, 0xa8, 0xa3,
+	0x2a, 0x25, 0xa5, 0x92, 0xb5, 0x20, 0x0a, 0x3d, 0x75, 0xca, 0xef, 0x14, 0x28, 0xd4, 0x39, 0xd9,
+	0xee, 0xe1, 0xe6, 0x33, 0x4c, 0xec, 0x66, 0x7f, 0x8b, 0xb9, 0x02, 0xbb, 0x02, 0x6d, 0x40, 0xae,
+	0x19, 0x3e, 0x06, 0xae, 0x6b, 0x3e, 0x68, 0x6d, 0xea, 0xdb, 0xe1, 0x4a, 0x4e, 0x7a, 0xac, 0xc8,
+	0xe1, 0x03, 0xd8, 0x1d, 0xb1, 0xcf, 0xda, 0x54, 0xf4, 0xd5, 0x74, 0x40, 0x37, 0x08, 0xac, 0xe7,
+	0x7d, 0x80, 0xc1, 0x7b, 0x59, 0x87, 0x85, 0xab, 0x5a, 0x88, 0x20, 0xca, 0x5f, 0x15, 0xc8, 0xd5,
+	0x39, 0xd9, 0x65, 0x02, 0xa3, 0xd5, 0x2b, 0x80, 0x6a, 0x33, 0xbf, 0x4e, 0x17, 0x2f, 0x86, 0x2f,
+	0x12, 0x22, 0x03, 0xc6, 0xba, 0x4c, 0xe0, 0xb6, 0x9a, 0x1e, 0xb1, 0x37, 0xa1, 0x0c, 0x55, 0x61,
+	0x9c, 0x79, 0x82, 0x32, 0x37, 0xd8, 0xcc, 0xfc, 0xe0, 0x3c, 0x84, 0xe3, 0x61, 0xf8, 0x6d, 0x3c,
+	0x0f, 0x04, 0x96, 0x14, 0xde, 0xb4, 0x97, 0xeb, 0xe0, 0xc3, 0x86, 0xa5, 0xcb, 0xb3, 0x30, 0x23,
+	0x39, 0x62, 0xb6, 0x13, 0x25, 0x8e, 0xbd, 0xc4, 0x94, 0xec, 0x0b, 0xec, 0xfc, 0x05, 0xc6, 0x0d,
+	0xc8, 0x85, 0xad, 0x73, 0x35, 0x13, 0x1c, 0xfa, 0xa5, 0x21, 0xc8, 0xa8, 0x97, 0x0b, 0xb0, 0x91,
+	0xe3, 0xd6, 0xb4, 0x45, 0x98, 0x1f, 0x22, 0x8b, 0xa9, 0xbf, 0x28, 0x00, 0x75, 0x4e, 0xa2, 0x09,
+	0xfa, 0x73, 0xe0, 0x7b, 0x30, 0x29, 0xa7, 0x96, 0x8d, 0x86, 0x1e, 0x48, 0xd1, 0x7d, 0x18, 0xb7,
+	0x5b, 0xac, 0xe3, 0x0a, 0xc9, 0x3d, 0x72, 0xd8, 0xa5, 0x5c, 0x9e, 0xd9, 0xb8, 0x50, 0xb9, 0x00,
+	0x68, 0x00, 0x10, 0x71, 0xad, 0x7d, 0xca, 0x40, 0xa6, 0xce, 0x09, 0x7a, 0x0d, 0xf9, 0xa1, 0x3f,
+	0xa8, 0xd2, 0xd0, 0x07, 0x4e, 0x8c, 0xac, 0x56, 0x19, 0xa5, 0x88, 0x87, 0x1a, 0xc3, 0x6c, 0x72,
+	0x5e, 0xff, 0x4d, 0xda, 0x13, 0x22, 0x6d, 0xf9, 0x16, 0xa2, 0x78, 0x99, 0x87, 0x90, 0x0d, 0x46,
+	0x6e, 0x2e, 0x69, 0xf2, 0xe3, 0x9a, 0x7e, 0x75, 0x3c, 0xf6, 0xef, 0xc2, 0x3f, 0x97, 0x8e, 0xf5,
+	0x35, 0xfa, 0x28, 0xaf, 0xfd, 0x77, 0x73, 0x3e, 0xae, 0xfb, 0x18, 0x72, 0xd1, 0xc1, 0x29, 0x26,
+	0x2d, 0x32, 0xa5, 0x2d, 0x5d, 0x9b, 0x8a, 0x0a, 0xd5, 0xb6, 0x8f, 0xce, 0x74, 0xe5, 0xf8, 0x4c,
+	0x57, 0x7e, 0x9c, 0xe9, 0xca, 0xc7, 0x73, 0x3d, 0x75, 0x7c, 0xae, 0xa7, 0x4e, 0xce, 0xf5, 0xd4,
+	0xab, 0x65, 0x42, 0xc5, 0x7e, 0xa7, 0x61, 0x34, 0x59, 0x4b, 0xde, 0x58, 0xf2, 0x67, 0x85, 0x3b,
+	0x6f, 0xcd, 0x5e, 0x70, 0xf5, 0x89, 0xbe, 0x87, 0xb9, 0x7f, 0x3f, 0x8e, 0x07, 0x7f, 0x88, 0x77,
+	0x7e, 0x0f, 0x00, 0x36, 0x9f, 0xb6, 0x01, 0x5f, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -872,6 +882,16 @@ func (m *MsgSubmitProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Expedited {
+		i--
+		if m.Expedited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.Metadata) > 0 {
 		i -= len(m.Metadata)
 		copy(dAtA[i:], m.Metadata)
@@ -1268,6 +1288,9 @@ func (m *MsgSubmitProposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.Expedited {
+		n += 2
+	}
 	return n
 }
 
@@ -1574,6 +1597,26 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expedited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expedited = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -70,3 +70,19 @@ func (rtr *router) GetRoute(path string) Handler {
 
 	return rtr.routes[path]
 }
+
+// HandlerRoute is a legacy proposal route and handler which modules can inject
+// using the dependency injection container in order to register it with the
+// gov legacy proposal router.
+type HandlerRoute struct {
+	RouteKey string
+	Handler  Handler
+}
+
+// NewHandlerRoute returns a HandlerRoute for the provided route key and handler.
+func NewHandlerRoute(routeKey string, handler Handler) HandlerRoute {
+	return HandlerRoute{RouteKey: routeKey, Handler: handler}
+}
+
+// IsAutoGroupType implements the container.AutoGroupType interface.
+func (HandlerRoute) IsAutoGroupType() {}
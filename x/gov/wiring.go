@@ -0,0 +1,87 @@
+package gov
+
+import (
+	modulev1 "github.com/cosmos/cosmos-sdk/api/cosmos/gov/module/v1"
+	"github.com/cosmos/cosmos-sdk/appconfig"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/container"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/middleware"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+)
+
+func init() {
+	appconfig.Register(&modulev1.Module{},
+		appconfig.Provide(provideModuleBasic, provideModule),
+	)
+}
+
+func provideModuleBasic() runtime.AppModuleBasicWrapper {
+	return runtime.WrapAppModuleBasic(AppModuleBasic{})
+}
+
+type govInputs struct {
+	container.In
+
+	Config           *modulev1.Module
+	Key              *storetypes.KVStoreKey
+	Cdc              codec.Codec
+	Subspace         paramstypes.Subspace
+	AccountKeeper    authkeeper.AccountKeeper
+	BankKeeper       bankkeeper.Keeper
+	StakingKeeper    *stakingkeeper.Keeper
+	MsgServiceRouter *middleware.MsgServiceRouter
+	LegacyRoutes     []v1beta1.HandlerRoute
+	Hooks            []types.GovHooksWrapper
+}
+
+type govOutputs struct {
+	container.Out
+
+	GovKeeper keeper.Keeper
+	Module    runtime.AppModuleWrapper
+}
+
+func provideModule(in govInputs) govOutputs {
+	legacyRouter := v1beta1.NewRouter()
+	legacyRouter.AddRoute(types.RouterKey, v1beta1.ProposalHandler)
+	for _, route := range in.LegacyRoutes {
+		legacyRouter.AddRoute(route.RouteKey, route.Handler)
+	}
+
+	config := types.DefaultConfig()
+	if in.Config.MaxMetadataLen != 0 {
+		config.MaxMetadataLen = in.Config.MaxMetadataLen
+	}
+
+	subspace := in.Subspace
+	if !subspace.HasKeyTable() {
+		subspace = subspace.WithKeyTable(v1.ParamKeyTable())
+	}
+
+	k := keeper.NewKeeper(
+		in.Cdc, in.Key, subspace, in.AccountKeeper, in.BankKeeper,
+		in.StakingKeeper, legacyRouter, in.MsgServiceRouter, config,
+	)
+
+	if len(in.Hooks) != 0 {
+		hooks := make([]types.GovHooks, len(in.Hooks))
+		for i, wrapper := range in.Hooks {
+			hooks[i] = wrapper.GovHooks
+		}
+
+		k.SetHooks(types.NewMultiGovHooks(hooks...))
+	}
+
+	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper)
+
+	return govOutputs{GovKeeper: k, Module: runtime.WrapAppModule(m)}
+}
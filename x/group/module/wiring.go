@@ -0,0 +1,60 @@
+package module
+
+import (
+	modulev1 "github.com/cosmos/cosmos-sdk/api/cosmos/group/module/v1"
+	"github.com/cosmos/cosmos-sdk/appconfig"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/container"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/middleware"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/cosmos/cosmos-sdk/x/group/keeper"
+)
+
+func init() {
+	appconfig.Register(&modulev1.Module{},
+		appconfig.Provide(provideModuleBasic, provideModule),
+	)
+}
+
+func provideModuleBasic() runtime.AppModuleBasicWrapper {
+	return runtime.WrapAppModuleBasic(AppModuleBasic{})
+}
+
+type groupInputs struct {
+	container.In
+
+	Config           *modulev1.Module
+	Key              *storetypes.KVStoreKey
+	Cdc              codec.Codec
+	Registry         cdctypes.InterfaceRegistry
+	MsgServiceRouter *middleware.MsgServiceRouter
+	AccountKeeper    authkeeper.AccountKeeper
+	BankKeeper       bankkeeper.Keeper
+}
+
+type groupOutputs struct {
+	container.Out
+
+	GroupKeeper keeper.Keeper
+	Module      runtime.AppModuleWrapper
+}
+
+func provideModule(in groupInputs) groupOutputs {
+	config := group.DefaultConfig()
+	if in.Config.MaxExecutionPeriod != nil {
+		config.MaxExecutionPeriod = in.Config.MaxExecutionPeriod.AsDuration()
+	}
+	if in.Config.MaxMetadataLen != 0 {
+		config.MaxMetadataLen = in.Config.MaxMetadataLen
+	}
+
+	k := keeper.NewKeeper(in.Key, in.Cdc, in.MsgServiceRouter, in.AccountKeeper, config)
+	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper, in.Registry)
+
+	return groupOutputs{GroupKeeper: k, Module: runtime.WrapAppModule(m)}
+}
@@ -0,0 +1,60 @@
+package mint
+
+import (
+	modulev1 "github.com/cosmos/cosmos-sdk/api/cosmos/mint/module/v1"
+	"github.com/cosmos/cosmos-sdk/appconfig"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/container"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/mint/keeper"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+)
+
+func init() {
+	appconfig.Register(&modulev1.Module{},
+		appconfig.Provide(provideModuleBasic, provideModule),
+	)
+}
+
+func provideModuleBasic() runtime.AppModuleBasicWrapper {
+	return runtime.WrapAppModuleBasic(AppModuleBasic{})
+}
+
+type mintInputs struct {
+	container.In
+
+	Config        *modulev1.Module
+	Key           *storetypes.KVStoreKey
+	Cdc           codec.Codec
+	Subspace      paramstypes.Subspace
+	AccountKeeper authkeeper.AccountKeeper
+	BankKeeper    bankkeeper.Keeper
+	StakingKeeper *stakingkeeper.Keeper
+}
+
+type mintOutputs struct {
+	container.Out
+
+	MintKeeper keeper.Keeper
+	Module     runtime.AppModuleWrapper
+}
+
+func provideModule(in mintInputs) mintOutputs {
+	feeCollectorName := in.Config.FeeCollectorName
+	if feeCollectorName == "" {
+		feeCollectorName = authtypes.FeeCollectorName
+	}
+
+	k := keeper.NewKeeper(
+		in.Cdc, in.Key, in.Subspace, in.StakingKeeper,
+		in.AccountKeeper, in.BankKeeper, feeCollectorName,
+	)
+	m := NewAppModule(in.Cdc, k, in.AccountKeeper, nil)
+
+	return mintOutputs{MintKeeper: k, Module: runtime.WrapAppModule(m)}
+}
@@ -0,0 +1,48 @@
+package nft
+
+import (
+	modulev1 "github.com/cosmos/cosmos-sdk/api/cosmos/nft/module/v1"
+	"github.com/cosmos/cosmos-sdk/appconfig"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/container"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/nft/keeper"
+)
+
+func init() {
+	appconfig.Register(&modulev1.Module{},
+		appconfig.Provide(provideModuleBasic, provideModule),
+	)
+}
+
+func provideModuleBasic() runtime.AppModuleBasicWrapper {
+	return runtime.WrapAppModuleBasic(AppModuleBasic{})
+}
+
+type nftInputs struct {
+	container.In
+
+	Key           *storetypes.KVStoreKey
+	Cdc           codec.Codec
+	Registry      cdctypes.InterfaceRegistry
+	AccountKeeper authkeeper.AccountKeeper
+	BankKeeper    bankkeeper.Keeper
+}
+
+type nftOutputs struct {
+	container.Out
+
+	NFTKeeper keeper.Keeper
+	Module    runtime.AppModuleWrapper
+}
+
+func provideModule(in nftInputs) nftOutputs {
+	k := keeper.NewKeeper(in.Key, in.Cdc, in.AccountKeeper, in.BankKeeper)
+	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper, in.Registry)
+
+	return nftOutputs{NFTKeeper: k, Module: runtime.WrapAppModule(m)}
+}
@@ -0,0 +1,67 @@
+package params
+
+import (
+	modulev1 "github.com/cosmos/cosmos-sdk/api/cosmos/params/module/v1"
+	"github.com/cosmos/cosmos-sdk/appconfig"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/container"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	"github.com/cosmos/cosmos-sdk/x/params/keeper"
+	"github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+)
+
+func init() {
+	appconfig.Register(&modulev1.Module{},
+		appconfig.Provide(provideModuleBasic, provideModule),
+		appconfig.ProvideModuleScoped(provideSubspace),
+	)
+}
+
+func provideModuleBasic() runtime.AppModuleBasicWrapper {
+	return runtime.WrapAppModuleBasic(AppModuleBasic{})
+}
+
+type paramsInputs struct {
+	container.In
+
+	KVStoreKey        *storetypes.KVStoreKey
+	TransientStoreKey *storetypes.TransientStoreKey
+	Cdc               codec.Codec
+	LegacyAmino       *codec.LegacyAmino
+}
+
+type paramsOutputs struct {
+	container.Out
+
+	ParamsKeeper  keeper.Keeper
+	BaseAppOption runtime.BaseAppOption
+	Module        runtime.AppModuleWrapper
+	GovHandler    govv1beta1.HandlerRoute
+}
+
+func provideModule(in paramsInputs) paramsOutputs {
+	k := keeper.NewKeeper(in.Cdc, in.LegacyAmino, in.KVStoreKey, in.TransientStoreKey)
+
+	// the BaseApp's parameter store is set once the BaseApp has been created
+	paramStore := k.Subspace(baseapp.Paramspace).WithKeyTable(types.ConsensusParamsKeyTable())
+	baseAppOption := func(app *baseapp.BaseApp) {
+		app.SetParamStore(paramStore)
+	}
+
+	return paramsOutputs{
+		ParamsKeeper:  k,
+		BaseAppOption: baseAppOption,
+		Module:        runtime.WrapAppModule(NewAppModule(k)),
+		GovHandler:    govv1beta1.NewHandlerRoute(proposal.RouterKey, NewParamChangeProposalHandler(k)),
+	}
+}
+
+// provideSubspace provides each module which depends on a param subspace with
+// a subspace named after the module.
+func provideSubspace(key container.ModuleKey, k keeper.Keeper) types.Subspace {
+	return k.Subspace(key.Name())
+}
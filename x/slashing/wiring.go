@@ -0,0 +1,56 @@
+package slashing
+
+import (
+	modulev1 "github.com/cosmos/cosmos-sdk/api/cosmos/slashing/module/v1"
+	"github.com/cosmos/cosmos-sdk/appconfig"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/container"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/keeper"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func init() {
+	appconfig.Register(&modulev1.Module{},
+		appconfig.Provide(provideModuleBasic, provideModule),
+	)
+}
+
+func provideModuleBasic() runtime.AppModuleBasicWrapper {
+	return runtime.WrapAppModuleBasic(AppModuleBasic{})
+}
+
+type slashingInputs struct {
+	container.In
+
+	Key           *storetypes.KVStoreKey
+	Cdc           codec.Codec
+	Subspace      paramstypes.Subspace
+	AccountKeeper authkeeper.AccountKeeper
+	BankKeeper    bankkeeper.Keeper
+	StakingKeeper *stakingkeeper.Keeper
+}
+
+type slashingOutputs struct {
+	container.Out
+
+	SlashingKeeper keeper.Keeper
+	Module         runtime.AppModuleWrapper
+	StakingHooks   stakingtypes.StakingHooksWrapper
+}
+
+func provideModule(in slashingInputs) slashingOutputs {
+	k := keeper.NewKeeper(in.Cdc, in.Key, in.StakingKeeper, in.Subspace)
+	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper, *in.StakingKeeper)
+
+	return slashingOutputs{
+		SlashingKeeper: k,
+		Module:         runtime.WrapAppModule(m),
+		StakingHooks:   stakingtypes.StakingHooksWrapper{StakingHooks: k.Hooks()},
+	}
+}
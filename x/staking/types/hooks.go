@@ -94,3 +94,11 @@ func (h MultiStakingHooks) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.V
 	}
 	return nil
 }
+
+// StakingHooksWrapper is a wrapper for modules to inject StakingHooks using
+// the dependency injection container. The hooks of all modules are combined
+// in the order in which the modules appear in the app config.
+type StakingHooksWrapper struct{ StakingHooks }
+
+// IsAutoGroupType implements the container.AutoGroupType interface.
+func (StakingHooksWrapper) IsAutoGroupType() {}
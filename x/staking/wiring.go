@@ -0,0 +1,67 @@
+package staking
+
+import (
+	modulev1 "github.com/cosmos/cosmos-sdk/api/cosmos/staking/module/v1"
+	"github.com/cosmos/cosmos-sdk/appconfig"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/container"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func init() {
+	appconfig.Register(&modulev1.Module{},
+		appconfig.Provide(provideModuleBasic, provideKeeper, provideModule),
+	)
+}
+
+func provideModuleBasic() runtime.AppModuleBasicWrapper {
+	return runtime.WrapAppModuleBasic(AppModuleBasic{})
+}
+
+type stakingInputs struct {
+	container.In
+
+	Key           *storetypes.KVStoreKey
+	Cdc           codec.Codec
+	Subspace      paramstypes.Subspace
+	AccountKeeper authkeeper.AccountKeeper
+	BankKeeper    bankkeeper.Keeper
+}
+
+// provideKeeper provides a pointer to the staking keeper so that the staking
+// hooks, which are set once all the modules providing hooks have been built,
+// are visible to the modules that depend on the keeper.
+func provideKeeper(in stakingInputs) *keeper.Keeper {
+	k := keeper.NewKeeper(in.Cdc, in.Key, in.AccountKeeper, in.BankKeeper, in.Subspace)
+	return &k
+}
+
+type stakingModuleInputs struct {
+	container.In
+
+	Keeper        *keeper.Keeper
+	Cdc           codec.Codec
+	AccountKeeper authkeeper.AccountKeeper
+	BankKeeper    bankkeeper.Keeper
+	Hooks         []types.StakingHooksWrapper
+}
+
+func provideModule(in stakingModuleInputs) runtime.AppModuleWrapper {
+	if len(in.Hooks) != 0 {
+		hooks := make([]types.StakingHooks, len(in.Hooks))
+		for i, wrapper := range in.Hooks {
+			hooks[i] = wrapper.StakingHooks
+		}
+
+		in.Keeper.SetHooks(types.NewMultiStakingHooks(hooks...))
+	}
+
+	m := NewAppModule(in.Cdc, *in.Keeper, in.AccountKeeper, in.BankKeeper)
+	return runtime.WrapAppModule(m)
+}
@@ -71,6 +71,10 @@ func (b AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry
 type AppModule struct {
 	AppModuleBasic
 	keeper keeper.Keeper
+
+	// versionMap optionally returns the consensus versions of the app's
+	// modules which are stored at genesis when it is set
+	versionMap func() module.VersionMap
 }
 
 // NewAppModule creates a new AppModule object
@@ -103,8 +107,14 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
 }
 
-// InitGenesis is ignored, no sense in serializing future upgrades
-func (am AppModule) InitGenesis(_ sdk.Context, _ codec.JSONCodec, _ json.RawMessage) []abci.ValidatorUpdate {
+// InitGenesis stores the module version map if the app module was created
+// with one. The genesis state itself is ignored, no sense in serializing
+// future upgrades.
+func (am AppModule) InitGenesis(ctx sdk.Context, _ codec.JSONCodec, _ json.RawMessage) []abci.ValidatorUpdate {
+	if am.versionMap != nil {
+		am.keeper.SetModuleVersionMap(ctx, am.versionMap())
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
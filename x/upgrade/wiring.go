@@ -0,0 +1,76 @@
+package upgrade
+
+import (
+	"github.com/spf13/cast"
+
+	modulev1 "github.com/cosmos/cosmos-sdk/api/cosmos/upgrade/module/v1"
+	"github.com/cosmos/cosmos-sdk/appconfig"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/container"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+func init() {
+	appconfig.Register(&modulev1.Module{},
+		appconfig.Provide(provideModuleBasic, provideModule),
+	)
+}
+
+func provideModuleBasic() runtime.AppModuleBasicWrapper {
+	return runtime.WrapAppModuleBasic(AppModuleBasic{})
+}
+
+type upgradeInputs struct {
+	container.In
+
+	Key        *storetypes.KVStoreKey
+	Cdc        codec.Codec
+	App        *runtime.App
+	AppOptions servertypes.AppOptions `optional:"true"`
+}
+
+type upgradeOutputs struct {
+	container.Out
+
+	UpgradeKeeper keeper.Keeper
+	Module        runtime.AppModuleWrapper
+	GovHandler    govv1beta1.HandlerRoute
+}
+
+func provideModule(in upgradeInputs) upgradeOutputs {
+	var (
+		homePath           string
+		skipUpgradeHeights = map[int64]bool{}
+	)
+	if in.AppOptions != nil {
+		homePath = cast.ToString(in.AppOptions.Get(flags.FlagHome))
+		for _, h := range cast.ToIntSlice(in.AppOptions.Get(server.FlagUnsafeSkipUpgrades)) {
+			skipUpgradeHeights[int64(h)] = true
+		}
+	}
+
+	// set the governance module account as the authority for conducting upgrades
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	k := keeper.NewKeeper(skipUpgradeHeights, in.Key, in.Cdc, homePath, in.App, authority)
+
+	m := NewAppModule(k)
+	m.versionMap = func() module.VersionMap {
+		return in.App.ModuleManager.GetVersionMap()
+	}
+
+	return upgradeOutputs{
+		UpgradeKeeper: k,
+		Module:        runtime.WrapAppModule(m),
+		GovHandler:    govv1beta1.NewHandlerRoute(types.RouterKey, NewSoftwareUpgradeProposalHandler(k)),
+	}
+}